@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type Account struct {
+	UserID                    int    `json:"userId"`
+	CompanyName               string `json:"companyName"`
+	UserName                  string `json:"userName"`
+	IsTeamLeader              bool   `json:"isTeamLeader"`
+	AccountBalance            string `json:"accountBalance"`
+	AccountBalanceRawCurrency string `json:"accountBalanceRawCurrency"`
+}
+
+func (c *Client) GetAccount(ctx context.Context) (Account, error) {
+	uri := c.baseURL + "account/accountInformation"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Account{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return Account{}, errors.New("unexpected http error code received for geting Account data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var account Account
+	if err := json.NewDecoder(resp.Body).Decode(&account); err != nil {
+		return Account{}, err
+	}
+
+	return account, nil
+}
@@ -1,13 +1,202 @@
 package api
 
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+)
+
 const BASE_URL = "https://api.webdock.io/v1/"
 
+// CACHE_TTL is how long successful list responses are reused
+const CACHE_TTL = 30 * time.Second
+
+// ErrNotFound is returned when the requested object does not exist
+var ErrNotFound = errors.New("not found")
+
+// Default request limits used when the provider does not configure them
+const (
+	DEFAULT_MAX_CONCURRENT_REQUESTS = 5
+	DEFAULT_REQUESTS_PER_SECOND     = 5
+)
+
 type Client struct {
-	token string
+	token   string
+	baseURL string
+
+	// cache of list endpoints shared by all resources and data sources,
+	// generation is bumped by every write so requests started before it
+	// do not fill the cache with a stale response
+	mu         sync.Mutex
+	cache      map[string]cachedResponse
+	requests   singleflight.Group
+	flights    map[string]int
+	generation uint64
+
+	// request limits shared by all resources and data sources, nil means unlimited
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+type cachedResponse struct {
+	body    []byte
+	expires time.Time
+}
+
+// cachedGet hold the result of a GET request shared between concurrent callers
+type cachedGet struct {
+	statusCode int
+	body       []byte
 }
 
 func NewClient(token string) *Client {
 	return &Client{
-		token: token,
+		token:   token,
+		baseURL: BASE_URL,
+		cache:   map[string]cachedResponse{},
+		flights: map[string]int{},
+	}
+}
+
+// SetRequestLimits bound the number of in flight requests and the request rate,
+// a zero value disables the corresponding limit
+func (c *Client) SetRequestLimits(maxConcurrentRequests int, requestsPerSecond float64) {
+	c.slots = nil
+	if maxConcurrentRequests > 0 {
+		c.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	c.limiter = nil
+	if requestsPerSecond > 0 {
+		burst := int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
+
+// do send a request to Webdock once a concurrency slot and a rate limit token are available
+func (c *Client) do(ctx context.Context, method, uri string, body []byte) (*http.Response, error) {
+	start := time.Now()
+
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "request queued, max concurrent requests reached", map[string]any{"method": method, "uri": uri})
+			select {
+			case c.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		defer func() { <-c.slots }()
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if waited := time.Since(start); waited > time.Millisecond {
+		tflog.Debug(ctx, "request waited for the request limiter", map[string]any{"method": method, "uri": uri, "wait": waited.String()})
+	}
+
+	return helper.NewWebdockRequest(ctx, method, uri, body, c.token)
+}
+
+// getCached send a GET request and cache successful responses for CACHE_TTL,
+// concurrent identical requests are deduplicated into a single call
+func (c *Client) getCached(ctx context.Context, uri string) (int, []byte, error) {
+	c.mu.Lock()
+	entry, ok := c.cache[uri]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		tflog.Debug(ctx, "using cached response", map[string]any{"uri": uri})
+		return http.StatusOK, entry.body, nil
+	}
+
+	// The shared request must outlive the caller that started it, every
+	// caller stops waiting on its own context instead
+	sharedCtx := context.WithoutCancel(ctx)
+	results := c.requests.DoChan(uri, func() (interface{}, error) {
+		c.mu.Lock()
+		generation := c.generation
+		c.flights[uri]++
+		c.mu.Unlock()
+		defer func() {
+			c.mu.Lock()
+			if c.flights[uri]--; c.flights[uri] == 0 {
+				delete(c.flights, uri)
+			}
+			c.mu.Unlock()
+		}()
+
+		resp, err := c.do(sharedCtx, http.MethodGet, uri, nil)
+		if err != nil {
+			return cachedGet{}, err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return cachedGet{}, err
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			c.mu.Lock()
+			if c.generation == generation {
+				c.cache[uri] = cachedResponse{body: body, expires: time.Now().Add(CACHE_TTL)}
+			}
+			c.mu.Unlock()
+		}
+
+		return cachedGet{statusCode: resp.StatusCode, body: body}, nil
+	})
+
+	var result singleflight.Result
+	select {
+	case result = <-results:
+	case <-ctx.Done():
+		return 0, nil, ctx.Err()
+	}
+	if result.Err != nil {
+		return 0, nil, result.Err
+	}
+	if result.Shared {
+		tflog.Debug(ctx, "shared response of concurrent request", map[string]any{"uri": uri})
+	}
+
+	get := result.Val.(cachedGet)
+	return get.statusCode, get.body, nil
+}
+
+// invalidate drop cached responses of uris starting with prefix after a change,
+// requests in flight are forgotten so later callers do not share their stale response
+func (c *Client) invalidate(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for uri := range c.cache {
+		if strings.HasPrefix(uri, prefix) {
+			delete(c.cache, uri)
+		}
+	}
+	for uri := range c.flights {
+		if strings.HasPrefix(uri, prefix) {
+			c.requests.Forget(uri)
+		}
 	}
 }
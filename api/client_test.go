@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetCachedSurvivesCancelledCaller(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	client := NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Write([]byte("[]"))
+	}))
+	uri := client.baseURL + "locations"
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, _, err := client.getCached(firstCtx, uri)
+		first <- err
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	second := make(chan int, 1)
+	go func() {
+		status, _, err := client.getCached(context.Background(), uri)
+		if err != nil {
+			t.Errorf("second caller failed: %v", err)
+		}
+		second <- status
+	}()
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected first caller to be cancelled, got %v", err)
+	}
+
+	close(release)
+	if status := <-second; status != http.StatusOK {
+		t.Errorf("expected second caller to get %d, got %d", http.StatusOK, status)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected a single shared request, got %d", got)
+	}
+}
+
+func TestInvalidateDropsStaleFlight(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	client := NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			<-release
+			w.Write([]byte("old"))
+			return
+		}
+		w.Write([]byte("new"))
+	}))
+	uri := client.baseURL + "servers"
+
+	stale := make(chan string, 1)
+	go func() {
+		_, body, err := client.getCached(context.Background(), uri)
+		if err != nil {
+			t.Errorf("stale caller failed: %v", err)
+		}
+		stale <- string(body)
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A write while the first request is in flight
+	client.invalidate(client.baseURL + "servers")
+
+	if _, body, err := client.getCached(context.Background(), uri); err != nil || string(body) != "new" {
+		t.Fatalf("expected a new request after invalidate, got %q, %v", body, err)
+	}
+
+	close(release)
+	if body := <-stale; body != "old" {
+		t.Errorf("expected the stale caller to get its own response, got %q", body)
+	}
+
+	if _, body, _ := client.getCached(context.Background(), uri); string(body) != "new" {
+		t.Errorf("expected the cache to hold the response after the write, got %q", body)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestGetPublicKeyByIdNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": 1, "name": "laptop", "key": "ssh-ed25519 AAAA"}]`))
+	}))
+
+	if key, err := client.GetPublicKeyById(context.Background(), "1"); err != nil || key.Name != "laptop" {
+		t.Errorf("expected key 1, got %+v, %v", key, err)
+	}
+	if _, err := client.GetPublicKeyById(context.Background(), "2"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CALLBACK_ID_HEADER is the response header holding the callback ID of an async action
+const CALLBACK_ID_HEADER = "X-Callback-ID"
+
+const (
+	// CALLBACK_POLL_INTERVAL is the delay between two checks of an async action
+	CALLBACK_POLL_INTERVAL = 5 * time.Second
+	// CALLBACK_TIMEOUT is the maximum time to wait for an async action
+	CALLBACK_TIMEOUT = 30 * time.Minute
+)
+
+// EVENTS_PAGE_SIZE is the number of events requested per page
+const EVENTS_PAGE_SIZE = 100
+
+type Event struct {
+	ID         int    `json:"id"`
+	StartTime  string `json:"startTime"`
+	EndTime    string `json:"endTime"`
+	CallbackID string `json:"callbackId"`
+	ServerSlug string `json:"serverSlug"`
+	EventType  string `json:"eventType"`
+	Action     string `json:"action"`
+	ActionData string `json:"actionData"`
+	Status     string `json:"status"`
+	Message    string `json:"message"`
+}
+
+type EventFilter struct {
+	CallbackID string
+	EventType  string
+}
+
+// ListEvents fetch every page of events matching the filter
+func (c *Client) ListEvents(ctx context.Context, filter EventFilter) ([]Event, error) {
+	query := url.Values{}
+	if filter.CallbackID != "" {
+		query.Set("callbackId", filter.CallbackID)
+	}
+	if filter.EventType != "" {
+		query.Set("eventType", filter.EventType)
+	}
+	query.Set("per_page", strconv.Itoa(EVENTS_PAGE_SIZE))
+
+	events := []Event{}
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		pageEvents, err := c.listEventsPage(ctx, c.baseURL+"events?"+query.Encode())
+		if err != nil {
+			return []Event{}, err
+		}
+		events = append(events, pageEvents...)
+		if len(pageEvents) < EVENTS_PAGE_SIZE {
+			return events, nil
+		}
+	}
+}
+
+func (c *Client) listEventsPage(ctx context.Context, uri string) ([]Event, error) {
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, errors.New("unexpected http error code received for geting Event data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+
+	var events []Event
+	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// WaitForCallback poll the events of an async action until it finish or fail
+func (c *Client) WaitForCallback(ctx context.Context, callbackID string) error {
+	if callbackID == "" {
+		return errors.New("no callback ID received for the action")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, CALLBACK_TIMEOUT)
+	defer cancel()
+
+	ticker := time.NewTicker(CALLBACK_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		events, err := c.ListEvents(ctx, EventFilter{CallbackID: callbackID})
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			switch event.Status {
+			case "finished":
+				return nil
+			case "error":
+				return errors.New("action " + event.Action + " failed: " + event.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.New("timeout waiting for action with callback ID " + callbackID + " to finish")
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestListEventsPages(t *testing.T) {
+	t.Parallel()
+
+	total := EVENTS_PAGE_SIZE + 3
+	client := NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("eventType"); got != "reboot" {
+			t.Errorf("expected the eventType filter to be sent, got %q", got)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		events := []Event{}
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+			events = append(events, Event{ID: id})
+		}
+		json.NewEncoder(w).Encode(events)
+	}))
+
+	events, err := client.ListEvents(context.Background(), EventFilter{EventType: "reboot"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != total {
+		t.Fatalf("expected %d events, got %d", total, len(events))
+	}
+	for i, event := range events {
+		if event.ID != i+1 {
+			t.Fatalf("expected event %d at index %d, got %d", i+1, i, event.ID)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type FirewallRule struct {
+	Action    string `json:"action"`
+	Protocol  string `json:"protocol"`
+	PortRange string `json:"portRange"`
+	Source    string `json:"source,omitempty"`
+}
+
+type Firewall struct {
+	Rules []FirewallRule `json:"rules"`
+}
+
+func (c *Client) GetFirewall(ctx context.Context, slug string) (Firewall, error) {
+	uri := c.baseURL + "servers/" + slug + "/firewall"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Firewall{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return Firewall{}, errors.New("unexpected http error code received for geting firewall data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var firewall Firewall
+	if err := json.NewDecoder(resp.Body).Decode(&firewall); err != nil {
+		return Firewall{}, err
+	}
+
+	return firewall, nil
+}
+
+// UpdateFirewall replace the firewall rules of the server, the returned
+// callback ID can be used to wait for the rules to be applied
+func (c *Client) UpdateFirewall(ctx context.Context, slug string, firewall Firewall) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/firewall"
+
+	jsonPayload, err := json.Marshal(firewall)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for updating firewall status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type FloatingIP struct {
+	ID         int    `json:"id"`
+	Address    string `json:"address"`
+	Location   string `json:"location"`
+	ServerSlug string `json:"serverSlug"`
+	Status     string `json:"status"`
+}
+
+type FloatingIPRequest struct {
+	LocationID string `json:"locationId"`
+}
+
+type FloatingIPAssignRequest struct {
+	ServerSlug string `json:"serverSlug"`
+}
+
+func (c *Client) GetFloatingIPById(ctx context.Context, id string) (FloatingIP, error) {
+	uri := c.baseURL + "floatingIps/" + id
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return FloatingIP{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return FloatingIP{}, errors.New("unexpected http error code received for geting floating ip data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var floatingIP FloatingIP
+	if err := json.NewDecoder(resp.Body).Decode(&floatingIP); err != nil {
+		return FloatingIP{}, err
+	}
+
+	return floatingIP, nil
+}
+
+func (c *Client) CreateFloatingIP(ctx context.Context, floatingIPRequest FloatingIPRequest) (FloatingIP, error) {
+	uri := c.baseURL + "floatingIps"
+
+	jsonPayload, err := json.Marshal(floatingIPRequest)
+	if err != nil {
+		return FloatingIP{}, err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
+	if err != nil {
+		return FloatingIP{}, err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return FloatingIP{}, errors.New("unexpected http error code received for creating floating ip status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var floatingIP FloatingIP
+	if err := json.NewDecoder(resp.Body).Decode(&floatingIP); err != nil {
+		return FloatingIP{}, err
+	}
+
+	return floatingIP, nil
+}
+
+func (c *Client) DeleteFloatingIP(ctx context.Context, id string) error {
+	uri := c.baseURL + "floatingIps/" + id
+
+	resp, err := c.do(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return errors.New("unexpected http error code received for deleting floating ip status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// AssignFloatingIP route the floating ip to the server, moving it away from
+// its current server if any, the returned callback ID can be used to wait for
+// the assignment to finish
+func (c *Client) AssignFloatingIP(ctx context.Context, id string, assignRequest FloatingIPAssignRequest) (string, error) {
+	uri := c.baseURL + "floatingIps/" + id + "/assign"
+
+	jsonPayload, err := json.Marshal(assignRequest)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for assigning floating ip status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
+
+// UnassignFloatingIP detach the floating ip from its server, the returned
+// callback ID can be used to wait for the change to finish
+func (c *Client) UnassignFloatingIP(ctx context.Context, id string) (string, error) {
+	uri := c.baseURL + "floatingIps/" + id + "/unassign"
+
+	resp, err := c.do(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for unassigning floating ip status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type (
+	Hook struct {
+		ID          int          `json:"id"`
+		CallbackURL string       `json:"callbackUrl"`
+		Filters     []HookFilter `json:"filters"`
+	}
+	HookFilter struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+)
+
+type HookRequest struct {
+	CallbackURL string `json:"callbackUrl"`
+	HookType    string `json:"hookType"`
+	HookValue   string `json:"hookValue,omitempty"`
+}
+
+func (c *Client) GetHookById(ctx context.Context, id string) (Hook, error) {
+	uri := c.baseURL + "hooks/" + id
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Hook{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return Hook{}, errors.New("unexpected http error code received for geting hook data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var hook Hook
+	if err := json.NewDecoder(resp.Body).Decode(&hook); err != nil {
+		return Hook{}, err
+	}
+
+	return hook, nil
+}
+
+func (c *Client) CreateHook(ctx context.Context, hookRequest HookRequest) (Hook, error) {
+	uri := c.baseURL + "hooks"
+
+	jsonPayload, err := json.Marshal(hookRequest)
+	if err != nil {
+		return Hook{}, err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
+	if err != nil {
+		return Hook{}, err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return Hook{}, errors.New("unexpected http error code received for creating hook status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var hook Hook
+	if err := json.NewDecoder(resp.Body).Decode(&hook); err != nil {
+		return Hook{}, err
+	}
+
+	return hook, nil
+}
+
+func (c *Client) DeleteHook(ctx context.Context, id string) error {
+	uri := c.baseURL + "hooks/" + id
+
+	resp, err := c.do(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return errors.New("unexpected http error code received for deleting hook status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
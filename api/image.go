@@ -4,11 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io"
 	"net/http"
 	"strconv"
-
-	"github.com/hmada15/terraform-provider-webdock/helper"
 )
 
 type (
@@ -21,20 +18,18 @@ type (
 )
 
 func (c *Client) ListImages(ctx context.Context) ([]Image, error) {
-	uri := BASE_URL + "images"
+	uri := c.baseURL + "images"
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	statusCode, body, err := c.getCached(ctx, uri)
 	if err != nil {
 		return []Image{}, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return []Image{}, errors.New("unexpected http error code received for geting serverImages data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	if statusCode != http.StatusOK {
+		return []Image{}, errors.New("unexpected http error code received for geting serverImages data status code :" + strconv.Itoa(statusCode) + " body" + string(body))
 	}
-	defer resp.Body.Close()
 
 	var images []Image
-	if err := json.NewDecoder(resp.Body).Decode(&images); err != nil {
+	if err := json.Unmarshal(body, &images); err != nil {
 		return []Image{}, err
 	}
 
@@ -4,11 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io"
 	"net/http"
 	"strconv"
-
-	"github.com/hmada15/terraform-provider-webdock/helper"
 )
 
 type Location struct {
@@ -21,20 +18,18 @@ type Location struct {
 }
 
 func (c *Client) ListLocations(ctx context.Context) ([]Location, error) {
-	uri := BASE_URL + "locations"
+	uri := c.baseURL + "locations"
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	statusCode, body, err := c.getCached(ctx, uri)
 	if err != nil {
 		return []Location{}, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return []Location{}, errors.New("unexpected http error code received for geting Location data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	if statusCode != http.StatusOK {
+		return []Location{}, errors.New("unexpected http error code received for geting Location data status code :" + strconv.Itoa(statusCode) + " body" + string(body))
 	}
-	defer resp.Body.Close()
 
 	var locations []Location
-	if err := json.NewDecoder(resp.Body).Decode(&locations); err != nil {
+	if err := json.Unmarshal(body, &locations); err != nil {
 		return []Location{}, err
 	}
 
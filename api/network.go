@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type IPAddress struct {
+	Address    string `json:"address"`
+	Version    int64  `json:"version"`
+	Gateway    string `json:"gateway"`
+	Netmask    string `json:"netmask"`
+	Prefix     int64  `json:"prefix"`
+	ReverseDNS string `json:"reverseDns"`
+}
+
+type ReverseDNSRequest struct {
+	Address    string `json:"address"`
+	ReverseDNS string `json:"reverseDns"`
+}
+
+// ListServerAddresses returns all the IPv4 and IPv6 addresses assigned to the server
+func (c *Client) ListServerAddresses(ctx context.Context, slug string) ([]IPAddress, error) {
+	uri := c.baseURL + "servers/" + slug + "/network"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, errors.New("unexpected http error code received for listing server addresses status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	addresses := []IPAddress{}
+	if err := json.NewDecoder(resp.Body).Decode(&addresses); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// UpdateReverseDNS set the PTR record of one of the server addresses, an empty
+// reverse DNS restore the Webdock default, the returned callback ID can be
+// used to wait for the change to finish
+func (c *Client) UpdateReverseDNS(ctx context.Context, slug string, rdnsRequest ReverseDNSRequest) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/network/rdns"
+
+	jsonPayload, err := json.Marshal(rdnsRequest)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for updating reverse dns status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
@@ -4,11 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io"
 	"net/http"
 	"strconv"
-
-	"github.com/hmada15/terraform-provider-webdock/helper"
 )
 
 type (
@@ -31,20 +28,18 @@ type (
 )
 
 func (c *Client) ListProfiles(ctx context.Context, locationId string) ([]Profile, error) {
-	uri := BASE_URL + "profiles?locationId=" + locationId
+	uri := c.baseURL + "profiles?locationId=" + locationId
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	statusCode, body, err := c.getCached(ctx, uri)
 	if err != nil {
 		return []Profile{}, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return []Profile{}, errors.New("unexpected http error code received for geting Profile data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	if statusCode != http.StatusOK {
+		return []Profile{}, errors.New("unexpected http error code received for geting Profile data status code :" + strconv.Itoa(statusCode) + " body" + string(body))
 	}
-	defer resp.Body.Close()
 
 	var profiles []Profile
-	if err := json.NewDecoder(resp.Body).Decode(&profiles); err != nil {
+	if err := json.Unmarshal(body, &profiles); err != nil {
 		return []Profile{}, err
 	}
 
@@ -4,11 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
-
-	"github.com/hmada15/terraform-provider-webdock/helper"
 )
 
 type PublicKey struct {
@@ -22,48 +21,53 @@ type PublicKeyRequest struct {
 	PublicKey string `json:"publicKey"`
 }
 
-func (c *Client) GetPublicKeyById(ctx context.Context, id string) (PublicKey, error) {
-	uri := BASE_URL + "account/publicKeys"
+func (c *Client) ListPublicKeys(ctx context.Context) ([]PublicKey, error) {
+	uri := c.baseURL + "account/publicKeys"
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	statusCode, body, err := c.getCached(ctx, uri)
 	if err != nil {
-		return PublicKey{}, err
+		return []PublicKey{}, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return PublicKey{}, errors.New("unexpected http error code received for geting PublicKey data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	if statusCode != http.StatusOK {
+		return []PublicKey{}, errors.New("unexpected http error code received for geting PublicKey data status code :" + strconv.Itoa(statusCode) + " body" + string(body))
 	}
-	defer resp.Body.Close()
 
 	var publicKeys []PublicKey
-	if err := json.NewDecoder(resp.Body).Decode(&publicKeys); err != nil {
+	if err := json.Unmarshal(body, &publicKeys); err != nil {
+		return []PublicKey{}, err
+	}
+
+	return publicKeys, nil
+}
+
+func (c *Client) GetPublicKeyById(ctx context.Context, id string) (PublicKey, error) {
+	publicKeys, err := c.ListPublicKeys(ctx)
+	if err != nil {
 		return PublicKey{}, err
 	}
 
-	publicKey := PublicKey{}
 	idStr, err := strconv.Atoi(id)
 	if err != nil {
 		return PublicKey{}, err
 	}
 	for _, key := range publicKeys {
 		if key.ID == idStr {
-			publicKey = key
-			break
+			return key, nil
 		}
 	}
 
-	return publicKey, nil
+	return PublicKey{}, fmt.Errorf("public key %s: %w", id, ErrNotFound)
 }
 
 func (c *Client) CreatePublicKey(ctx context.Context, publicKeyRequest PublicKeyRequest) (PublicKey, error) {
-	uri := BASE_URL + "account/publicKeys"
+	uri := c.baseURL + "account/publicKeys"
 
 	jsonPayload, err := json.Marshal(publicKeyRequest)
 	if err != nil {
 		return PublicKey{}, err
 	}
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodPost, uri, jsonPayload, c.token)
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
 	if err != nil {
 		return PublicKey{}, err
 	}
@@ -72,6 +76,34 @@ func (c *Client) CreatePublicKey(ctx context.Context, publicKeyRequest PublicKey
 		return PublicKey{}, errors.New("unexpected http error code received for creating publickey status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
 	}
 	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "account/publicKeys")
+
+	var publicKey PublicKey
+	if err := json.NewDecoder(resp.Body).Decode(&publicKey); err != nil {
+		return PublicKey{}, err
+	}
+
+	return publicKey, nil
+}
+
+func (c *Client) UpdatePublicKey(ctx context.Context, id string, publicKeyRequest PublicKeyRequest) (PublicKey, error) {
+	uri := c.baseURL + "account/publicKeys/" + id
+
+	jsonPayload, err := json.Marshal(publicKeyRequest)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	resp, err := c.do(ctx, http.MethodPatch, uri, jsonPayload)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return PublicKey{}, errors.New("unexpected http error code received for updating publickey status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "account/publicKeys")
 
 	var publicKey PublicKey
 	if err := json.NewDecoder(resp.Body).Decode(&publicKey); err != nil {
@@ -82,9 +114,9 @@ func (c *Client) CreatePublicKey(ctx context.Context, publicKeyRequest PublicKey
 }
 
 func (c *Client) DeletePublicKey(ctx context.Context, id string) error {
-	uri := BASE_URL + "account/publicKeys/" + id
+	uri := c.baseURL + "account/publicKeys/" + id
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodDelete, uri, nil, c.token)
+	resp, err := c.do(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
 		return err
 	}
@@ -93,6 +125,7 @@ func (c *Client) DeletePublicKey(ctx context.Context, id string) error {
 		return errors.New("unexpected http error code received for deleting publickey status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
 	}
 	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "account/publicKeys")
 
 	return nil
 }
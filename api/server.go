@@ -24,24 +24,63 @@ type Server struct {
 	Status                 string `json:"status"`
 	Virtualization         string `json:"virtualization"`
 	WebServer              string `json:"webServer"`
+	PhpVersion             string `json:"phpVersion"`
 	SnapshotRunTime        int64  `json:"snapshotRunTime"`
+	SnapshotRetention      int64  `json:"snapshotRetention"`
+	Description            string `json:"description"`
+	Notes                  string `json:"notes"`
 	WordPressLockDown      bool   `json:"WordPressLockDown"`
 	SSHPasswordAuthEnabled bool   `json:"SSHPasswordAuthEnabled"`
 }
 
+type ServerUpdateRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Notes       string `json:"notes"`
+}
+
+type ServerSettingsRequest struct {
+	WordPressLockDown      *bool   `json:"WordPressLockDown,omitempty"`
+	SSHPasswordAuthEnabled *bool   `json:"SSHPasswordAuthEnabled,omitempty"`
+	WebServer              *string `json:"webServer,omitempty"`
+	PhpVersion             *string `json:"phpVersion,omitempty"`
+	SnapshotRunTime        *int64  `json:"snapshotRunTime,omitempty"`
+	SnapshotRetention      *int64  `json:"snapshotRetention,omitempty"`
+}
+
 type ServerRequest struct {
 	Name           string `json:"name"`
 	Slug           string `json:"slug,omitempty"`
 	LocationID     string `json:"locationId"`
 	ProfileSlug    string `json:"profileSlug"`
 	Virtualization string `json:"virtualization,omitempty"`
-	ImageSlug      string `json:"imageSlug"`
+	ImageSlug      string `json:"imageSlug,omitempty"`
+	SnapshotID     int    `json:"snapshotId,omitempty"`
+}
+
+func (c *Client) ListServers(ctx context.Context) ([]Server, error) {
+	uri := c.baseURL + "servers"
+
+	statusCode, body, err := c.getCached(ctx, uri)
+	if err != nil {
+		return []Server{}, err
+	}
+	if statusCode != http.StatusOK {
+		return []Server{}, errors.New("unexpected http error code received for listing servers status code :" + strconv.Itoa(statusCode) + " body" + string(body))
+	}
+
+	var servers []Server
+	if err := json.Unmarshal(body, &servers); err != nil {
+		return []Server{}, err
+	}
+
+	return servers, nil
 }
 
 func (c *Client) GetServerBYSlug(ctx context.Context, slug string) (Server, error) {
-	uri := BASE_URL + "servers/" + slug
+	uri := c.baseURL + "servers/" + slug
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return Server{}, err
 	}
@@ -59,41 +98,44 @@ func (c *Client) GetServerBYSlug(ctx context.Context, slug string) (Server, erro
 	return server, nil
 }
 
-func (c *Client) CreateServer(ctx context.Context, serverRequest ServerRequest) (Server, error) {
-	uri := BASE_URL + "servers"
+// CreateServer create a new server, the returned callback ID can be used
+// to wait for the server to be provisioned
+func (c *Client) CreateServer(ctx context.Context, serverRequest ServerRequest) (Server, string, error) {
+	uri := c.baseURL + "servers"
 
 	jsonPayload, err := json.Marshal(serverRequest)
 	if err != nil {
-		return Server{}, err
+		return Server{}, "", err
 	}
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodPost, uri, jsonPayload, c.token)
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
 	if err != nil {
-		return Server{}, err
+		return Server{}, "", err
 	}
 	if resp.StatusCode != http.StatusAccepted {
 		body, _ := io.ReadAll(resp.Body)
-		return Server{}, errors.New("unexpected http error code received for creating server status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+		return Server{}, "", errors.New("unexpected http error code received for creating server status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
 	}
 	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "servers")
 
 	var server Server
 	if err := json.NewDecoder(resp.Body).Decode(&server); err != nil {
-		return Server{}, err
+		return Server{}, "", err
 	}
 
-	return server, nil
+	return server, resp.Header.Get(CALLBACK_ID_HEADER), nil
 }
 
-func (c *Client) UpdateServer(ctx context.Context, slug string, serverRequest ServerRequest) (Server, error) {
-	uri := BASE_URL + "servers/" + slug
+func (c *Client) UpdateServer(ctx context.Context, slug string, serverUpdateRequest ServerUpdateRequest) (Server, error) {
+	uri := c.baseURL + "servers/" + slug
 
-	jsonPayload, err := json.Marshal(serverRequest)
+	jsonPayload, err := json.Marshal(serverUpdateRequest)
 	if err != nil {
 		return Server{}, err
 	}
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodPatch, uri, jsonPayload, c.token)
+	resp, err := c.do(ctx, http.MethodPatch, uri, jsonPayload)
 	if err != nil {
 		return Server{}, err
 	}
@@ -102,6 +144,7 @@ func (c *Client) UpdateServer(ctx context.Context, slug string, serverRequest Se
 		return Server{}, errors.New("unexpected http error code received for updating server status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
 	}
 	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "servers")
 
 	var server Server
 	if err := json.NewDecoder(resp.Body).Decode(&server); err != nil {
@@ -112,9 +155,9 @@ func (c *Client) UpdateServer(ctx context.Context, slug string, serverRequest Se
 }
 
 func (c *Client) DeleteServer(ctx context.Context, slug string) error {
-	uri := BASE_URL + "servers/" + slug
+	uri := c.baseURL + "servers/" + slug
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodDelete, uri, nil, c.token)
+	resp, err := c.do(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
 		return err
 	}
@@ -123,14 +166,15 @@ func (c *Client) DeleteServer(ctx context.Context, slug string) error {
 		return errors.New("unexpected http error code received for deleting server status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
 	}
 	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "servers")
 
 	return nil
 }
 
 func (c *Client) ServerExist(ctx context.Context, slug string) (string, error) {
-	uri := BASE_URL + "servers/" + slug
+	uri := c.baseURL + "servers/" + slug
 
-	resp, err := helper.NewWebdockRequest(ctx, http.MethodGet, uri, nil, c.token)
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return "", err
 	}
@@ -145,3 +189,51 @@ func (c *Client) ServerExist(ctx context.Context, slug string) (string, error) {
 
 	return helper.YES, nil
 }
+
+// ReinstallServer reinstall the server with a new image keeping its slug and IPs,
+// the returned callback ID can be used to wait for the reinstall to finish
+func (c *Client) ReinstallServer(ctx context.Context, slug string, imageSlug string) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/actions/reinstall"
+
+	jsonPayload, err := json.Marshal(map[string]string{"imageSlug": imageSlug})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for reinstalling server status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "servers")
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
+
+// UpdateServerSettings change the server settings, only the fields set in the request are changed.
+// The returned callback ID can be used to wait for the change to finish
+func (c *Client) UpdateServerSettings(ctx context.Context, slug string, settingsRequest ServerSettingsRequest) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/settings"
+
+	jsonPayload, err := json.Marshal(settingsRequest)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPatch, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for updating server settings status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+	c.invalidate(c.baseURL + "servers")
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
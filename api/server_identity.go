@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type ServerIdentity struct {
+	MainDomain   string   `json:"mainDomain"`
+	AliasDomains []string `json:"aliasDomains"`
+}
+
+type ServerIdentityRequest struct {
+	MainDomain   string   `json:"mainDomain"`
+	AliasDomains []string `json:"aliasDomains"`
+}
+
+type Certificate struct {
+	Status  string   `json:"status"`
+	Domains []string `json:"domains"`
+	Expires string   `json:"expires"`
+}
+
+type CertificateRequest struct {
+	Domains []string `json:"domains"`
+}
+
+func (c *Client) GetServerIdentity(ctx context.Context, slug string) (ServerIdentity, error) {
+	uri := c.baseURL + "servers/" + slug + "/identity"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ServerIdentity{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return ServerIdentity{}, errors.New("unexpected http error code received for geting server identity status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var identity ServerIdentity
+	if err := json.NewDecoder(resp.Body).Decode(&identity); err != nil {
+		return ServerIdentity{}, err
+	}
+
+	return identity, nil
+}
+
+// UpdateServerIdentity set the main domain and aliases of the server,
+// the returned callback ID can be used to wait for the change to finish
+func (c *Client) UpdateServerIdentity(ctx context.Context, slug string, identityRequest ServerIdentityRequest) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/identity"
+
+	jsonPayload, err := json.Marshal(identityRequest)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for updating server identity status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
+
+func (c *Client) GetServerCertificate(ctx context.Context, slug string) (Certificate, error) {
+	uri := c.baseURL + "servers/" + slug + "/identity/certificate"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Certificate{}, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return Certificate{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return Certificate{}, errors.New("unexpected http error code received for geting server certificate status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var certificate Certificate
+	if err := json.NewDecoder(resp.Body).Decode(&certificate); err != nil {
+		return Certificate{}, err
+	}
+
+	return certificate, nil
+}
+
+// RequestServerCertificate request a Let's Encrypt certificate for the domains,
+// the returned callback ID can be used to wait for the certificate to be issued
+func (c *Client) RequestServerCertificate(ctx context.Context, slug string, certificateRequest CertificateRequest) (string, error) {
+	uri := c.baseURL + "servers/" + slug + "/identity/certificate"
+
+	jsonPayload, err := json.Marshal(certificateRequest)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, uri, jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.New("unexpected http error code received for requesting server certificate status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get(CALLBACK_ID_HEADER), nil
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type Snapshot struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Date           string `json:"date"`
+	Type           string `json:"type"`
+	Virtualization string `json:"virtualization"`
+	Completed      bool   `json:"completed"`
+	Deletable      bool   `json:"deletable"`
+}
+
+func (c *Client) ListSnapshots(ctx context.Context, slug string) ([]Snapshot, error) {
+	uri := c.baseURL + "servers/" + slug + "/snapshots"
+
+	resp, err := c.do(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Snapshot{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return []Snapshot{}, errors.New("unexpected http error code received for geting Snapshot data status code :" + strconv.Itoa(resp.StatusCode) + " body" + string(body))
+	}
+	defer resp.Body.Close()
+
+	var snapshots []Snapshot
+	if err := json.NewDecoder(resp.Body).Decode(&snapshots); err != nil {
+		return []Snapshot{}, err
+	}
+
+	return snapshots, nil
+}
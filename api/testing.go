@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// NewTestClient returns a Client whose requests are served by handler,
+// it is meant for the tests of this package and of the packages using it
+func NewTestClient(t testing.TB, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL + "/v1/"
+
+	return client
+}
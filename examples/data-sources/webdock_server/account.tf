@@ -0,0 +1 @@
+data "webdock_account" "this" {}
@@ -0,0 +1,5 @@
+data "webdock_events" "this" {
+  server_slug = "example"
+  event_type  = "provision"
+  since       = "2024-01-01T00:00:00Z"
+}
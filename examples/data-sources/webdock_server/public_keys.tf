@@ -0,0 +1,7 @@
+data "webdock_public_keys" "this" {
+  name_regex = "^deploy-"
+}
+
+data "webdock_public_key" "this" {
+  name = "example"
+}
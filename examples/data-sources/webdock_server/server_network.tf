@@ -0,0 +1,3 @@
+data "webdock_server_network" "this" {
+  server_slug = "example"
+}
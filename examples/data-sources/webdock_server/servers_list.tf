@@ -0,0 +1,3 @@
+data "webdock_servers" "this" {
+  tags = ["env:production"]
+}
@@ -9,5 +9,10 @@ terraform {
 
 
 provider "webdock" {
-  token = ""
+  token        = ""
+  default_tags = ["managed-by:terraform"]
+
+  # Throttle API calls when creating many servers in one apply
+  max_concurrent_requests = 5
+  requests_per_second     = 2
 }
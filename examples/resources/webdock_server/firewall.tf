@@ -0,0 +1,22 @@
+resource "webdock_firewall" "this" {
+  server_slug = webdock_server.this.slug
+
+  rule {
+    action     = "allow"
+    protocol   = "tcp"
+    port_range = "22"
+    source     = "203.0.113.0/24"
+  }
+
+  rule {
+    action     = "allow"
+    protocol   = "tcp"
+    port_range = "80-443"
+  }
+
+  rule {
+    action     = "deny"
+    protocol   = "any"
+    port_range = "1-65535"
+  }
+}
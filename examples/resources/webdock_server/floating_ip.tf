@@ -0,0 +1,13 @@
+resource "webdock_floating_ip" "this" {
+  location = "dk"
+}
+
+# Move the floating ip between servers without replacing it
+resource "webdock_floating_ip_assignment" "this" {
+  floating_ip_id = webdock_floating_ip.this.id
+  server_slug    = webdock_server.this.slug
+}
+
+output "floating_ip_address" {
+  value = webdock_floating_ip.this.address
+}
@@ -0,0 +1,5 @@
+resource "webdock_hook" "this" {
+  callback_url = "https://example.com/webdock/events"
+  hook_type    = "eventType"
+  hook_value   = "provision"
+}
@@ -0,0 +1,6 @@
+# Server can be imported by slug
+terraform import webdock_server.this example
+
+# or looked up by IPv4/IPv6 address or by name
+terraform import webdock_server.this ip:1.2.3.4
+terraform import webdock_server.this name:web-01
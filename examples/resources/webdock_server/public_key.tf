@@ -1,4 +1,4 @@
 resource "webdock_public_key" "edu" {
-  public_key = "example-public-key"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEggUnS627qcpqXPBObjdxOacOQKi3Flya5oqjZz9qUa example"
   name       = "example"
 }
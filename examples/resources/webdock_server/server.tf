@@ -1,8 +1,19 @@
 resource "webdock_server" "this" {
-  slug           = "example"
-  name           = "example"
-  location_id    = "fi"
-  profile_slug   = "webdockbit-2022"
-  virtualization = "container"
-  image_slug     = "krellide:webdock-jammy-lemp"
+  slug                      = "example"
+  name                      = "example"
+  location_id               = "fi"
+  profile_slug              = "webdockbit-2022"
+  virtualization            = "container"
+  image_slug                = "krellide:webdock-jammy-lemp"
+  ssh_password_auth_enabled = false
+  description               = "example web server"
+  tags                      = ["env:production", "team:web"]
+}
+
+# Clone the server from its latest snapshot into another location
+resource "webdock_server" "clone" {
+  name               = "example-clone"
+  location_id        = "dk"
+  profile_slug       = "webdockbit-2022"
+  source_server_slug = webdock_server.this.slug
 }
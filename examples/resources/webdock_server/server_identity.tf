@@ -0,0 +1,6 @@
+resource "webdock_server_identity" "this" {
+  server_slug   = webdock_server.this.slug
+  main_domain   = "example.com"
+  alias_domains = ["www.example.com"]
+  lets_encrypt  = true
+}
@@ -0,0 +1,11 @@
+resource "webdock_server_rdns" "ipv4" {
+  server_slug = webdock_server.this.slug
+  address     = webdock_server.this.ipv4
+  reverse_dns = "mail.example.com"
+}
+
+resource "webdock_server_rdns" "ipv6" {
+  server_slug = webdock_server.this.slug
+  address     = webdock_server.this.ipv6
+  reverse_dns = "mail.example.com"
+}
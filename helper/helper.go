@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"context"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 )
 
 const (
@@ -31,3 +34,104 @@ func NewWebdockRequest(ctx context.Context, method, url string, body []byte, tok
 
 	return resp, nil
 }
+
+// SlugFromName derive a server slug from its name the same way Webdock does
+// when no slug is given: lower case letters and digits only
+func SlugFromName(name string) string {
+	var slug strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			slug.WriteRune(r)
+		}
+	}
+
+	return slug.String()
+}
+
+// NormalizePublicKey reduce an authorized_keys formatted public key to its
+// algorithm and base64 blob so whitespace and comment differences are ignored
+func NormalizePublicKey(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// ParseTime parse a time given either in RFC3339 or in the Webdock API format
+func ParseTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Parse("2006-01-02 15:04:05", value)
+	}
+
+	return t, nil
+}
+
+// TAGS_START and TAGS_END delimit the tags section of the Webdock notes field
+const (
+	TAGS_START = "[tags]"
+	TAGS_END   = "[/tags]"
+)
+
+// splitNotes separate the free text of the Webdock notes field from its tags section
+func splitNotes(notes string) (string, string) {
+	start := strings.Index(notes, TAGS_START)
+	if start < 0 {
+		return notes, ""
+	}
+	end := strings.Index(notes[start:], TAGS_END)
+	if end < 0 {
+		return notes, ""
+	}
+	end += start
+
+	text := strings.TrimSuffix(notes[:start], "\n") + notes[end+len(TAGS_END):]
+	return text, notes[start+len(TAGS_START) : end]
+}
+
+// EncodeTags store server tags as a sorted comma separated list in a delimited
+// section of the Webdock notes field, the free text of the notes is kept
+func EncodeTags(notes string, tags []string) string {
+	text, _ := splitNotes(notes)
+	if len(tags) == 0 {
+		return text
+	}
+
+	sorted := slices.Clone(tags)
+	slices.Sort(sorted)
+	section := TAGS_START + strings.Join(sorted, ",") + TAGS_END
+	if text == "" {
+		return section
+	}
+
+	return text + "\n" + section
+}
+
+// DecodeTags read server tags back from the tags section of the Webdock notes field
+func DecodeTags(notes string) []string {
+	_, section := splitNotes(notes)
+
+	tags := []string{}
+	for _, tag := range strings.Split(section, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// MergeTags merge the default tags with the resource tags without duplicates
+func MergeTags(defaultTags, tags []string) []string {
+	merged := slices.Clone(defaultTags)
+	for _, tag := range tags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	slices.Sort(merged)
+
+	return merged
+}
@@ -0,0 +1,74 @@
+package helper
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlugFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "web01", want: "web01"},
+		{name: "Web 01", want: "web01"},
+		{name: "my-server.example_1", want: "myserverexample1"},
+		{name: "ÆØÅ server", want: "server"},
+		{name: "---", want: ""},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := SlugFromName(tt.name); got != tt.want {
+			t.Errorf("SlugFromName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeTags(t *testing.T) {
+	tests := []struct {
+		notes string
+		tags  []string
+		want  string
+	}{
+		{notes: "", tags: []string{"b", "a"}, want: "[tags]a,b[/tags]"},
+		{notes: "", tags: nil, want: ""},
+		{notes: "backup at night", tags: []string{"web"}, want: "backup at night\n[tags]web[/tags]"},
+		{notes: "backup at night\n[tags]old[/tags]", tags: []string{"new"}, want: "backup at night\n[tags]new[/tags]"},
+		{notes: "backup at night\n[tags]old[/tags]", tags: nil, want: "backup at night"},
+		{notes: "line one\n[tags]old[/tags]\nline two", tags: []string{"new"}, want: "line one\nline two\n[tags]new[/tags]"},
+	}
+
+	for _, tt := range tests {
+		if got := EncodeTags(tt.notes, tt.tags); got != tt.want {
+			t.Errorf("EncodeTags(%q, %v) = %q, want %q", tt.notes, tt.tags, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeTags(t *testing.T) {
+	tests := []struct {
+		notes string
+		want  []string
+	}{
+		{notes: "", want: []string{}},
+		{notes: "free text, with commas", want: []string{}},
+		{notes: "free text\n[tags]a, b[/tags]", want: []string{"a", "b"}},
+		{notes: "[tags]a,b", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := DecodeTags(tt.notes); !slices.Equal(got, tt.want) {
+			t.Errorf("DecodeTags(%q) = %v, want %v", tt.notes, got, tt.want)
+		}
+	}
+
+	// Decoding what was encoded gives the sorted tags back and keeps the notes stable
+	notes := EncodeTags("free text", []string{"team:web", "env:prod"})
+	if got := DecodeTags(notes); !slices.Equal(got, []string{"env:prod", "team:web"}) {
+		t.Errorf("DecodeTags(%q) = %v", notes, got)
+	}
+	if again := EncodeTags(notes, DecodeTags(notes)); again != notes {
+		t.Errorf("EncodeTags is not stable: %q != %q", again, notes)
+	}
+}
@@ -0,0 +1,53 @@
+package helper
+
+import (
+	"crypto/dsa" //nolint:staticcheck // DSA keys are still found in the wild and must be reported
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// PublicKeyInfo hold the details of a parsed SSH public key
+type PublicKeyInfo struct {
+	Algorithm         string
+	Bits              int
+	FingerprintSHA256 string
+	FingerprintMD5    string
+}
+
+// ParsePublicKey parse an authorized_keys formatted SSH public key
+// and reject private keys pasted by mistake
+func ParsePublicKey(key string) (PublicKeyInfo, error) {
+	if strings.Contains(key, "PRIVATE KEY") {
+		return PublicKeyInfo{}, errors.New("the value is a private key, only the public key must be given")
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return PublicKeyInfo{}, err
+	}
+
+	info := PublicKeyInfo{
+		Algorithm:         publicKey.Type(),
+		FingerprintSHA256: ssh.FingerprintSHA256(publicKey),
+		FingerprintMD5:    ssh.FingerprintLegacyMD5(publicKey),
+	}
+	if cryptoPublicKey, ok := publicKey.(ssh.CryptoPublicKey); ok {
+		switch k := cryptoPublicKey.CryptoPublicKey().(type) {
+		case *rsa.PublicKey:
+			info.Bits = k.N.BitLen()
+		case *ecdsa.PublicKey:
+			info.Bits = k.Curve.Params().BitSize
+		case ed25519.PublicKey:
+			info.Bits = len(k) * 8
+		case *dsa.PublicKey:
+			info.Bits = k.P.BitLen()
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+var (
+	_ datasource.DataSource              = &AccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccountDataSource{}
+)
+
+type AccountDataSource struct {
+	client *api.Client
+}
+
+type AccountDataSourceModel struct {
+	UserID         types.Int64  `tfsdk:"user_id"`
+	CompanyName    types.String `tfsdk:"company_name"`
+	UserName       types.String `tfsdk:"user_name"`
+	IsTeamLeader   types.Bool   `tfsdk:"is_team_leader"`
+	AccountBalance types.String `tfsdk:"account_balance"`
+	Currency       types.String `tfsdk:"currency"`
+	ServerCount    types.Int64  `tfsdk:"server_count"`
+}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+func (*AccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+// Schema defines the schema for the data source.
+func (d *AccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "User ID",
+			},
+			"company_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Company name",
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "User name",
+			},
+			"is_team_leader": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user is the team leader of the account",
+			},
+			"account_balance": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account balance",
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account balance currency",
+			},
+			"server_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of servers in the account",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AccountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Account Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `account` data source")
+
+	account, err := d.client.GetAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get `account`",
+			err.Error(),
+		)
+		return
+	}
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `server`",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := AccountDataSourceModel{
+		UserID:         types.Int64Value(int64(account.UserID)),
+		CompanyName:    types.StringValue(account.CompanyName),
+		UserName:       types.StringValue(account.UserName),
+		IsTeamLeader:   types.BoolValue(account.IsTeamLeader),
+		AccountBalance: types.StringValue(account.AccountBalance),
+		Currency:       types.StringValue(account.AccountBalanceRawCurrency),
+		ServerCount:    types.Int64Value(int64(len(servers))),
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `account` data source", map[string]any{"success": true})
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+)
+
+var (
+	_ datasource.DataSource              = &EventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &EventsDataSource{}
+)
+
+type EventsDataSource struct {
+	client *api.Client
+}
+
+type (
+	EventsDataSourceModel struct {
+		ServerSlug types.String  `tfsdk:"server_slug"`
+		EventType  types.String  `tfsdk:"event_type"`
+		Status     types.String  `tfsdk:"status"`
+		Since      types.String  `tfsdk:"since"`
+		Until      types.String  `tfsdk:"until"`
+		Events     []EventsModel `tfsdk:"events"`
+	}
+	EventsModel struct {
+		ID         types.Int64  `tfsdk:"id"`
+		CallbackID types.String `tfsdk:"callback_id"`
+		ServerSlug types.String `tfsdk:"server_slug"`
+		EventType  types.String `tfsdk:"event_type"`
+		Action     types.String `tfsdk:"action"`
+		StartTime  types.String `tfsdk:"start_time"`
+		EndTime    types.String `tfsdk:"end_time"`
+		Status     types.String `tfsdk:"status"`
+		Message    types.String `tfsdk:"message"`
+	}
+)
+
+func NewEventsDataSource() datasource.DataSource {
+	return &EventsDataSource{}
+}
+
+func (*EventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+// Schema defines the schema for the data source.
+func (d *EventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events of this server",
+			},
+			"event_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events of this type e.g. provision, snapshot, restart",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events with this status Enum: waiting, working, finished, error",
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events started at or after this time (RFC3339)",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events started at or before this time (RFC3339)",
+			},
+			"events": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Event ID",
+						},
+						"callback_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Callback ID of the action",
+						},
+						"server_slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server slug",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Event type",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "Action that ran",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start date/time",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "End date/time",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Event status Enum: waiting, working, finished, error",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Event message",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Events Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *EventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `events` data source")
+	var state EventsDataSourceModel
+
+	// get the user supplied data from the tf datasoruce block
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// parse the time window
+	var since, until time.Time
+	for _, window := range []struct {
+		name  string
+		value types.String
+		time  *time.Time
+	}{
+		{"since", state.Since, &since},
+		{"until", state.Until, &until},
+	} {
+		if window.value.IsNull() {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, window.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(window.name),
+				"Invalid "+window.name,
+				"Expected an RFC3339 time: "+err.Error(),
+			)
+			return
+		}
+		*window.time = t
+	}
+
+	events, err := d.client.ListEvents(ctx, api.EventFilter{EventType: state.EventType.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `event`",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Events = []EventsModel{}
+	for _, event := range events {
+		if !state.ServerSlug.IsNull() && event.ServerSlug != state.ServerSlug.ValueString() {
+			continue
+		}
+		if !state.Status.IsNull() && event.Status != state.Status.ValueString() {
+			continue
+		}
+		if !since.IsZero() || !until.IsZero() {
+			startTime, err := helper.ParseTime(event.StartTime)
+			if err != nil {
+				tflog.Warn(ctx, "Skipping `event` without a valid start time", map[string]any{"id": event.ID, "error": err.Error()})
+				continue
+			}
+			if (!since.IsZero() && startTime.Before(since)) || (!until.IsZero() && startTime.After(until)) {
+				continue
+			}
+		}
+
+		eventState := EventsModel{
+			ID:         types.Int64Value(int64(event.ID)),
+			CallbackID: types.StringValue(event.CallbackID),
+			ServerSlug: types.StringValue(event.ServerSlug),
+			EventType:  types.StringValue(event.EventType),
+			Action:     types.StringValue(event.Action),
+			StartTime:  types.StringValue(event.StartTime),
+			EndTime:    types.StringValue(event.EndTime),
+			Status:     types.StringValue(event.Status),
+			Message:    types.StringValue(event.Message),
+		}
+		state.Events = append(state.Events, eventState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `events` data source", map[string]any{"success": true})
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &FirewallResource{}
+	_ resource.ResourceWithConfigure   = &FirewallResource{}
+	_ resource.ResourceWithImportState = &FirewallResource{}
+)
+
+// NewFirewallResource is a helper function to simplify the provider implementation.
+func NewFirewallResource() resource.Resource {
+	return &FirewallResource{}
+}
+
+// FirewallResource is the resource implementation.
+type FirewallResource struct {
+	client *api.Client
+}
+
+// FirewallResource is the model implementation.
+type (
+	FirewallResourceModel struct {
+		ServerSlug  types.String        `tfsdk:"server_slug"`
+		Rules       []FirewallRuleModel `tfsdk:"rule"`
+		LastUpdated types.String        `tfsdk:"last_updated"`
+	}
+	FirewallRuleModel struct {
+		Action    types.String `tfsdk:"action"`
+		Protocol  types.String `tfsdk:"protocol"`
+		PortRange types.String `tfsdk:"port_range"`
+		Source    types.String `tfsdk:"source"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (s *FirewallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FirewallResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Firewall Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *FirewallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "Firewall rules, evaluated in order with the first matching rule applied",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "Rule action Enum: allow, deny",
+						},
+						"protocol": schema.StringAttribute{
+							Required:    true,
+							Description: "Rule protocol Enum: tcp, udp, any",
+						},
+						"port_range": schema.StringAttribute{
+							Required:    true,
+							Description: "Port or port range e.g. 22 or 8000-8100",
+						},
+						"source": schema.StringAttribute{
+							Optional:    true,
+							Description: "Source CIDR, any source when not set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Import using server slug as the attribute
+func (s *FirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_slug"), req, resp)
+}
+
+// Create a new resource.
+func (s *FirewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create firewall")
+	// Retrieve values from plan
+	var plan FirewallResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateFirewall(ctx, plan.ServerSlug.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall",
+			"Could not set firewall rules of webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create firewall request")
+}
+
+// Read resource information.
+func (s *FirewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read firewall")
+
+	// Get current state
+	var state FirewallResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send get firewall request")
+	firewall, err := s.client.GetFirewall(ctx, state.ServerSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock firewall",
+			"Could not read firewall of Webdock server "+state.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	rules := []FirewallRuleModel{}
+	for _, rule := range firewall.Rules {
+		ruleState := FirewallRuleModel{
+			Action:    types.StringValue(rule.Action),
+			Protocol:  types.StringValue(rule.Protocol),
+			PortRange: types.StringValue(rule.PortRange),
+			Source:    types.StringNull(),
+		}
+		if rule.Source != "" {
+			ruleState.Source = types.StringValue(rule.Source)
+		}
+		rules = append(rules, ruleState)
+	}
+
+	// The first matching rule wins, a reordering is drift like any other change
+	state.Rules = rules
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get firewall request")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *FirewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "update firewall")
+	// Retrieve values from plan
+	var plan FirewallResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateFirewall(ctx, plan.ServerSlug.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating firewall",
+			"Could not set firewall rules of webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update firewall request")
+}
+
+// Delete removes all the firewall rules and the Terraform state on success.
+func (s *FirewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete firewall")
+	// Get current state
+	var state FirewallResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateFirewall(ctx, state.ServerSlug.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleteing webdock firewall",
+			"Could not remove firewall rules of webdock server "+state.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// updateFirewall replace the server firewall rules and wait for them to be applied.
+func (s *FirewallResource) updateFirewall(ctx context.Context, slug string, rules []FirewallRuleModel) error {
+	firewall := api.Firewall{Rules: []api.FirewallRule{}}
+	for _, rule := range rules {
+		firewall.Rules = append(firewall.Rules, api.FirewallRule{
+			Action:    rule.Action.ValueString(),
+			Protocol:  rule.Protocol.ValueString(),
+			PortRange: rule.PortRange.ValueString(),
+			Source:    rule.Source.ValueString(),
+		})
+	}
+
+	tflog.Debug(ctx, "send update firewall request")
+	callbackID, err := s.client.UpdateFirewall(ctx, slug, firewall)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "wait for firewall update", map[string]any{"callback_id": callbackID})
+	return s.client.WaitForCallback(ctx, callbackID)
+}
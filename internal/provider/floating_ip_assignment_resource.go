@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &FloatingIPAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &FloatingIPAssignmentResource{}
+	_ resource.ResourceWithImportState = &FloatingIPAssignmentResource{}
+)
+
+// NewFloatingIPAssignmentResource is a helper function to simplify the provider implementation.
+func NewFloatingIPAssignmentResource() resource.Resource {
+	return &FloatingIPAssignmentResource{}
+}
+
+// FloatingIPAssignmentResource is the resource implementation.
+type FloatingIPAssignmentResource struct {
+	client *api.Client
+}
+
+// FloatingIPAssignmentResource is the model implementation.
+type FloatingIPAssignmentResourceModel struct {
+	FloatingIPID types.String `tfsdk:"floating_ip_id"`
+	ServerSlug   types.String `tfsdk:"server_slug"`
+	Address      types.String `tfsdk:"address"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (s *FloatingIPAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_floating_ip_assignment"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FloatingIPAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected FloatingIPAssignment Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *FloatingIPAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"floating_ip_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the floating ip",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the server the floating ip is assigned to, changing it moves the floating ip in place",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Assigned IP address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Import using floating ip id as the attribute
+func (s *FloatingIPAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("floating_ip_id"), req, resp)
+}
+
+// Create a new resource.
+func (s *FloatingIPAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create floating ip assignment")
+	// Retrieve values from plan
+	var plan FloatingIPAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.assign(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating floating ip assignment",
+			"Could not assign floating ip "+plan.FloatingIPID.ValueString()+" to webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create floating ip assignment request")
+}
+
+// Read resource information.
+func (s *FloatingIPAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read floating ip assignment")
+
+	// Get current state
+	var state FloatingIPAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send get floating ip request")
+	floatingIP, err := s.client.GetFloatingIPById(ctx, state.FloatingIPID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock floating ip assignment",
+			"Could not read Webdock floating ip id "+state.FloatingIPID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The floating ip was unassigned outside of terraform
+	if floatingIP.ServerSlug == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ServerSlug = types.StringValue(floatingIP.ServerSlug)
+	state.Address = types.StringValue(floatingIP.Address)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get floating ip assignment request")
+}
+
+// Update moves the floating ip to the new server and sets the updated Terraform state on success.
+func (s *FloatingIPAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "update floating ip assignment")
+	// Retrieve values from plan
+	var plan FloatingIPAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.assign(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating floating ip assignment",
+			"Could not assign floating ip "+plan.FloatingIPID.ValueString()+" to webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update floating ip assignment request")
+}
+
+// Delete unassigns the floating ip and removes the Terraform state on success.
+func (s *FloatingIPAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete floating ip assignment")
+	// Get current state
+	var state FloatingIPAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := unassignFloatingIP(ctx, s.client, state.FloatingIPID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleteing webdock floating ip assignment",
+			"Could not unassign webdock floating ip "+state.FloatingIPID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// assign routes the floating ip to the planned server and populates the computed attributes.
+func (s *FloatingIPAssignmentResource) assign(ctx context.Context, plan *FloatingIPAssignmentResourceModel) error {
+	id := plan.FloatingIPID.ValueString()
+
+	if err := assignFloatingIP(ctx, s.client, id, plan.ServerSlug.ValueString()); err != nil {
+		return err
+	}
+
+	floatingIP, err := s.client.GetFloatingIPById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	plan.Address = types.StringValue(floatingIP.Address)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return nil
+}
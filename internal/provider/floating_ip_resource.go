@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &FloatingIPResource{}
+	_ resource.ResourceWithConfigure   = &FloatingIPResource{}
+	_ resource.ResourceWithImportState = &FloatingIPResource{}
+)
+
+// NewFloatingIPResource is a helper function to simplify the provider implementation.
+func NewFloatingIPResource() resource.Resource {
+	return &FloatingIPResource{}
+}
+
+// FloatingIPResource is the resource implementation.
+type FloatingIPResource struct {
+	client *api.Client
+}
+
+// FloatingIPResource is the model implementation.
+type FloatingIPResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	ServerSlug  types.String `tfsdk:"server_slug"`
+	Address     types.String `tfsdk:"address"`
+	Status      types.String `tfsdk:"status"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (s *FloatingIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_floating_ip"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FloatingIPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected FloatingIP Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *FloatingIPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Required:    true,
+				Description: "Location ID of the floating ip",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "Slug of the server the floating ip is assigned to, leave unset when using webdock_floating_ip_assignment",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Allocated IP address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Floating ip status",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Import using id as the attribute
+func (s *FloatingIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new resource.
+func (s *FloatingIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create floating ip")
+	// Retrieve values from plan
+	var plan FloatingIPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send create floating ip request")
+	floatingIP, err := s.client.CreateFloatingIP(ctx, api.FloatingIPRequest{
+		LocationID: plan.Location.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating floating ip",
+			"Could not create floating ip, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := strconv.Itoa(floatingIP.ID)
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(id)
+	plan.Address = types.StringValue(floatingIP.Address)
+	plan.Status = types.StringValue(floatingIP.Status)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Track the allocated ip before assigning it, a failed assignment
+	// then taints it instead of leaking the address
+	if !plan.ServerSlug.IsNull() {
+		allocated := plan
+		allocated.ServerSlug = types.StringNull()
+		diags = resp.State.Set(ctx, allocated)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := assignFloatingIP(ctx, s.client, id, plan.ServerSlug.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating floating ip",
+				"Could not assign floating ip "+floatingIP.Address+" to webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		floatingIP, err = s.client.GetFloatingIPById(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Webdock floating ip",
+				"Could not read Webdock floating ip id "+id+": "+err.Error(),
+			)
+			return
+		}
+		plan.Status = types.StringValue(floatingIP.Status)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create floating ip request")
+}
+
+// Read resource information.
+func (s *FloatingIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read floating ip")
+
+	// Get current state
+	var state FloatingIPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send get floating ip request")
+	// Get refreshed floating ip value from Webdock
+	floatingIP, err := s.client.GetFloatingIPById(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock floating ip",
+			"Could not read Webdock floating ip id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(floatingIP.ID))
+	state.Location = types.StringValue(floatingIP.Location)
+	state.Address = types.StringValue(floatingIP.Address)
+	state.Status = types.StringValue(floatingIP.Status)
+	// The assignment is only tracked here when it is managed by this resource
+	if !state.ServerSlug.IsNull() {
+		state.ServerSlug = types.StringNull()
+		if floatingIP.ServerSlug != "" {
+			state.ServerSlug = types.StringValue(floatingIP.ServerSlug)
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get floating ip request")
+}
+
+// Update reassigns the floating ip and sets the updated Terraform state on success.
+func (s *FloatingIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "update floating ip")
+	// Retrieve values from plan
+	var plan, state FloatingIPResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	if !plan.ServerSlug.Equal(state.ServerSlug) {
+		var err error
+		if plan.ServerSlug.IsNull() {
+			err = unassignFloatingIP(ctx, s.client, id)
+		} else {
+			err = assignFloatingIP(ctx, s.client, id, plan.ServerSlug.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating floating ip",
+				"Could not update assignment of floating ip "+state.Address.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	floatingIP, err := s.client.GetFloatingIPById(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock floating ip",
+			"Could not read Webdock floating ip id "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.Address = types.StringValue(floatingIP.Address)
+	plan.Status = types.StringValue(floatingIP.Status)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update floating ip request")
+}
+
+// Delete releases the floating ip and removes the Terraform state on success.
+func (s *FloatingIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete floating ip")
+	// Get current state
+	var state FloatingIPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "send delete floating ip request")
+	// delete floating ip
+	err := s.client.DeleteFloatingIP(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleteing webdock floating ip",
+			"Could not delete webdock floating ip "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// assignFloatingIP route the floating ip to the server and wait for it to be applied.
+func assignFloatingIP(ctx context.Context, client *api.Client, id string, serverSlug string) error {
+	tflog.Debug(ctx, "send assign floating ip request", map[string]any{"server_slug": serverSlug})
+	callbackID, err := client.AssignFloatingIP(ctx, id, api.FloatingIPAssignRequest{ServerSlug: serverSlug})
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "wait for floating ip assignment", map[string]any{"callback_id": callbackID})
+	return client.WaitForCallback(ctx, callbackID)
+}
+
+// unassignFloatingIP detach the floating ip from its server and wait for it to be applied.
+func unassignFloatingIP(ctx context.Context, client *api.Client, id string) error {
+	tflog.Debug(ctx, "send unassign floating ip request")
+	callbackID, err := client.UnassignFloatingIP(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "wait for floating ip unassignment", map[string]any{"callback_id": callbackID})
+	return client.WaitForCallback(ctx, callbackID)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+)
+
+// stringsToValues convert a list of go strings to terraform strings.
+func stringsToValues(values []string) []types.String {
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
+
+// setValues convert a terraform set of strings to go strings.
+func setValues(set types.Set) []string {
+	result := []string{}
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok {
+			result = append(result, value.ValueString())
+		}
+	}
+
+	return result
+}
+
+// stringsToSet convert a list of go strings to a terraform set of strings.
+func stringsToSet(values []string) types.Set {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// tagsValidator reject tags that cannot be stored in the Webdock notes field,
+// tags are kept there as a comma separated list.
+type tagsValidator struct{}
+
+func (v tagsValidator) Description(_ context.Context) string {
+	return "tags must not contain commas or the " + helper.TAGS_START + " and " + helper.TAGS_END + " markers"
+}
+
+func (v tagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v tagsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		tag, ok := element.(types.String)
+		if !ok || tag.IsNull() || tag.IsUnknown() {
+			continue
+		}
+		value := tag.ValueString()
+		if strings.Contains(value, ",") || strings.Contains(value, helper.TAGS_START) || strings.Contains(value, helper.TAGS_END) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid tag",
+				"Tag "+value+" is invalid, "+v.Description(ctx)+".",
+			)
+		}
+	}
+}
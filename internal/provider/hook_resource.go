@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &HookResource{}
+	_ resource.ResourceWithConfigure   = &HookResource{}
+	_ resource.ResourceWithImportState = &HookResource{}
+)
+
+// NewHookResource is a helper function to simplify the provider implementation.
+func NewHookResource() resource.Resource {
+	return &HookResource{}
+}
+
+// HookResource is the resource implementation.
+type HookResource struct {
+	client *api.Client
+}
+
+// HookResource is the model implementation.
+type HookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	CallbackURL types.String `tfsdk:"callback_url"`
+	HookType    types.String `tfsdk:"hook_type"`
+	HookValue   types.String `tfsdk:"hook_value"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (s *HookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hook"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *HookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Hook Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *HookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Required:    true,
+				Description: "URL that receives the event notifications",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hook_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Event filter type Enum: serverSlug, eventType, callbackId",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hook_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "Value of the event filter e.g. a server slug or an event type like provision, snapshot",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Import using id as the attribute
+func (s *HookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new resource.
+func (s *HookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create hook")
+	// Retrieve values from plan
+	var plan HookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	hookRequest := api.HookRequest{
+		CallbackURL: plan.CallbackURL.ValueString(),
+		HookType:    plan.HookType.ValueString(),
+		HookValue:   plan.HookValue.ValueString(),
+	}
+
+	tflog.Debug(ctx, "send create hook request")
+	hook, err := s.client.CreateHook(ctx, hookRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating hook",
+			"Could not create hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.Itoa(hook.ID))
+	plan.CallbackURL = types.StringValue(hook.CallbackURL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create hook request")
+}
+
+// Read resource information.
+func (s *HookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read hook")
+
+	// Get current state
+	var state HookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send get hook request")
+	// Get refreshed hook value from Webdock
+	hook, err := s.client.GetHookById(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock hook",
+			"Could not read Webdock hook id "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// hook_type is required, without a filter to read it from an imported hook
+	// would be replaced by the next plan
+	if len(hook.Filters) == 0 && state.HookType.IsNull() {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock hook",
+			"Webdock hook id "+state.ID.ValueString()+" has no event filter so its hook_type cannot be read. "+
+				"Hooks without an event filter cannot be imported.",
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(strconv.Itoa(hook.ID))
+	state.CallbackURL = types.StringValue(hook.CallbackURL)
+	if len(hook.Filters) > 0 {
+		state.HookType = types.StringValue(hook.Filters[0].Type)
+		state.HookValue = types.StringNull()
+		if hook.Filters[0].Value != "" {
+			state.HookValue = types.StringValue(hook.Filters[0].Value)
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get hook request")
+}
+
+func (s *HookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// updating resource is not supported, every attribute requires replace
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (s *HookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete hook")
+	// Get current state
+	var state HookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "send delete hook request")
+	// delete hook
+	err := s.client.DeleteHook(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleteing webdock hook",
+			"Could not delete webdock hook "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
@@ -165,7 +165,7 @@ func (d *ProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		}
 		profilestate.CPU.Cores = types.Int64Value(int64(profile.CPU.Cores))
 		profilestate.CPU.Threads = types.Int64Value(int64(profile.CPU.Threads))
-		profilestate.Price.Amount = types.Int64Value(int64(profile.CPU.Threads))
+		profilestate.Price.Amount = types.Int64Value(int64(profile.Price.Amount))
 		profilestate.Price.Currency = types.StringValue(profile.Price.Currency)
 
 		state.Profile = append(state.Profile, profilestate)
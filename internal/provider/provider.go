@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hmada15/terraform-provider-webdock/api"
@@ -23,7 +24,17 @@ type (
 	}
 
 	webdockProviderModel struct {
-		Token types.String `tfsdk:"token"`
+		Token                 types.String  `tfsdk:"token"`
+		DefaultTags           types.Set     `tfsdk:"default_tags"`
+		MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+		RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	}
+
+	// webdockProviderData is handed to the resources, it holds the provider
+	// settings next to the Webdock api client
+	webdockProviderData struct {
+		client      *api.Client
+		defaultTags []string
 	}
 )
 
@@ -50,6 +61,22 @@ func (p *webdockProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: "Webdock token",
 			},
+			"default_tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags merged into the tags of every taggable resource. Must not contain commas",
+				Validators: []validator.Set{
+					tagsValidator{},
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of Webdock API requests in flight at once, shared by all resources. Defaults to 5, 0 disables the limit",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum rate of Webdock API requests, shared by all resources. Defaults to 5, 0 disables the limit",
+			},
 		},
 	}
 }
@@ -74,6 +101,22 @@ func (p *webdockProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.MaxConcurrentRequests.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid max_concurrent_requests",
+			"max_concurrent_requests must be 0 or greater.",
+		)
+	}
+
+	if config.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid requests_per_second",
+			"requests_per_second must be 0 or greater.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,10 +151,27 @@ func (p *webdockProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Create a new Token client using the configuration values
 	client := api.NewClient(token)
 
+	maxConcurrentRequests := int64(api.DEFAULT_MAX_CONCURRENT_REQUESTS)
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = config.MaxConcurrentRequests.ValueInt64()
+	}
+	requestsPerSecond := float64(api.DEFAULT_REQUESTS_PER_SECOND)
+	if !config.RequestsPerSecond.IsNull() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	client.SetRequestLimits(int(maxConcurrentRequests), requestsPerSecond)
+	tflog.Debug(ctx, "Configured request limits", map[string]any{
+		"max_concurrent_requests": maxConcurrentRequests,
+		"requests_per_second":     requestsPerSecond,
+	})
+
 	// Make the Token client available during DataSource and Resource
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &webdockProviderData{
+		client:      client,
+		defaultTags: setValues(config.DefaultTags),
+	}
 
 	tflog.Info(ctx, "Configured webdock client", map[string]any{"success": true})
 }
@@ -120,9 +180,15 @@ func (p *webdockProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *webdockProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewServerDataSource,
+		NewServersDataSource,
 		NewLocationDataSource,
 		NewProfileDataSource,
 		NewImagesDataSource,
+		NewAccountDataSource,
+		NewPublicKeysDataSource,
+		NewPublicKeyDataSource,
+		NewEventsDataSource,
+		NewServerNetworkDataSource,
 	}
 }
 
@@ -131,5 +197,11 @@ func (p *webdockProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		NewServerResource,
 		NewPublicKeyResource,
+		NewHookResource,
+		NewServerIdentityResource,
+		NewFirewallResource,
+		NewServerRDNSResource,
+		NewFloatingIPResource,
+		NewFloatingIPAssignmentResource,
 	}
 }
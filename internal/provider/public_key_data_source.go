@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+)
+
+var (
+	_ datasource.DataSource              = &PublicKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &PublicKeyDataSource{}
+)
+
+type PublicKeyDataSource struct {
+	client *api.Client
+}
+
+type PublicKeyDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	Key               types.String `tfsdk:"key"`
+	Created           types.String `tfsdk:"created"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	FingerprintMD5    types.String `tfsdk:"fingerprint_md5"`
+}
+
+func NewPublicKeyDataSource() datasource.DataSource {
+	return &PublicKeyDataSource{}
+}
+
+func (*PublicKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *PublicKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the key to look up",
+			},
+			"fingerprint": schema.StringAttribute{
+				Optional:    true,
+				Description: "SHA256 or MD5 fingerprint of the key to look up",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key ID",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key content",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date/time",
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of the key",
+			},
+			"fingerprint_md5": schema.StringAttribute{
+				Computed:    true,
+				Description: "MD5 fingerprint of the key",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PublicKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected PublicKey Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *PublicKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `public_key` data source")
+	var state PublicKeyDataSourceModel
+
+	// get the user supplied data from the tf datasoruce block
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Name.IsNull() == state.Fingerprint.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid `public_key` lookup",
+			"Exactly one of name or fingerprint must be set.",
+		)
+		return
+	}
+
+	publicKeys, err := d.client.ListPublicKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `public_key`",
+			err.Error(),
+		)
+		return
+	}
+
+	var matches []api.PublicKey
+	var matchInfo helper.PublicKeyInfo
+	for _, publicKey := range publicKeys {
+		info, _ := helper.ParsePublicKey(publicKey.Key)
+		if (!state.Name.IsNull() && state.Name.ValueString() == publicKey.Name) ||
+			(!state.Fingerprint.IsNull() && (state.Fingerprint.ValueString() == info.FingerprintSHA256 || state.Fingerprint.ValueString() == info.FingerprintMD5)) {
+			matches = append(matches, publicKey)
+			matchInfo = info
+		}
+	}
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to find `public_key`",
+			fmt.Sprintf("Expected exactly one public key to match, found %d.", len(matches)),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue(strconv.Itoa(matches[0].ID))
+	state.Name = types.StringValue(matches[0].Name)
+	state.Key = types.StringValue(matches[0].Key)
+	state.Created = types.StringValue(matches[0].Created)
+	state.FingerprintSHA256 = types.StringValue(matchInfo.FingerprintSHA256)
+	state.FingerprintMD5 = types.StringValue(matchInfo.FingerprintMD5)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `public_key` data source", map[string]any{"success": true})
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -9,11 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hmada15/terraform-provider-webdock/api"
+	"github.com/hmada15/terraform-provider-webdock/helper"
 )
 
 // implement resource interfaces.
@@ -35,12 +39,16 @@ type PublicKeyResource struct {
 
 // PublicKeyResource is the model implementation.
 type PublicKeyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Key         types.String `tfsdk:"key"`
-	Created     types.String `tfsdk:"created"`
-	PublicKey   types.String `tfsdk:"public_key"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Key               types.String `tfsdk:"key"`
+	Created           types.String `tfsdk:"created"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	FingerprintMD5    types.String `tfsdk:"fingerprint_md5"`
+	Algorithm         types.String `tfsdk:"algorithm"`
+	Bits              types.Int64  `tfsdk:"bits"`
+	LastUpdated       types.String `tfsdk:"last_updated"`
 }
 
 // Metadata returns the resource type name.
@@ -54,16 +62,16 @@ func (d *PublicKeyResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	data, ok := req.ProviderData.(*webdockProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected PublicKey Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Schema defines the schema for the resource.
@@ -72,23 +80,69 @@ func (s *PublicKeyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"key": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"public_key": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "SSH public key in authorized_keys format",
+				Validators: []validator.String{
+					publicKeyValidator{},
+				},
+				// Requires Replace only if the key itself change, not its formatting or comment
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.PlanValue.IsUnknown() ||
+								helper.NormalizePublicKey(req.PlanValue.ValueString()) != helper.NormalizePublicKey(req.StateValue.ValueString())
+						},
+						"Replace the key if its content change, whitespace and comment changes are updated in place.",
+						"Replace the key if its content change, whitespace and comment changes are updated in place.",
+					),
+				},
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint_md5": schema.StringAttribute{
+				Computed:    true,
+				Description: "MD5 fingerprint of the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key algorithm e.g. ssh-ed25519, ssh-rsa",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bits": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Key size in bits",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -135,9 +189,10 @@ func (s *PublicKeyResource) Create(ctx context.Context, req resource.CreateReque
 		Name:        types.StringValue(publicKey.Name),
 		Key:         types.StringValue(publicKey.Key),
 		Created:     types.StringValue(publicKey.Created),
-		PublicKey:   types.StringValue(publicKey.Key),
+		PublicKey:   plan.PublicKey,
 		LastUpdated: types.StringValue(time.Now().Format(time.RFC850)),
 	}
+	plan.setKeyInfo(plan.PublicKey.ValueString())
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -163,6 +218,11 @@ func (s *PublicKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Debug(ctx, "send get public key request")
 	// Get refreshed public key value from Webdock
 	publicKey, err := s.client.GetPublicKeyById(ctx, state.ID.ValueString())
+	// The key was deleted outside of terraform
+	if errors.Is(err, api.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Webdock publickey",
@@ -170,22 +230,23 @@ func (s *PublicKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		)
 		return
 	}
-	if (api.PublicKey{}) == publicKey {
-		resp.Diagnostics.AddError(
-			"Unable to get publickey",
-			"Could not read Webdock publickey id "+state.ID.ValueString(),
-		)
-		return
+
+	// Keep the configured formatting of the key unless its content drifted
+	publicKeyValue := state.PublicKey
+	if helper.NormalizePublicKey(state.PublicKey.ValueString()) != helper.NormalizePublicKey(publicKey.Key) {
+		publicKeyValue = types.StringValue(publicKey.Key)
 	}
 
 	// Overwrite items with refreshed state
 	state = PublicKeyResourceModel{
-		ID:        types.StringValue(strconv.Itoa(publicKey.ID)),
-		Name:      types.StringValue(publicKey.Name),
-		Key:       types.StringValue(publicKey.Key),
-		PublicKey: types.StringValue(publicKey.Key),
-		Created:   types.StringValue(publicKey.Created),
+		ID:          types.StringValue(strconv.Itoa(publicKey.ID)),
+		Name:        types.StringValue(publicKey.Name),
+		Key:         types.StringValue(publicKey.Key),
+		PublicKey:   publicKeyValue,
+		Created:     types.StringValue(publicKey.Created),
+		LastUpdated: state.LastUpdated,
 	}
+	state.setKeyInfo(publicKey.Key)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -196,8 +257,49 @@ func (s *PublicKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Debug(ctx, "finish get publickey request")
 }
 
+// Update updates the resource and sets the updated Terraform state on success.
 func (s *PublicKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// updating resource is not supported
+	tflog.Debug(ctx, "update publickey")
+	// Retrieve values from plan
+	var plan PublicKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PublicKeyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the name can be changed in place, key formatting changes are kept in state only
+	if !plan.Name.Equal(state.Name) {
+		tflog.Debug(ctx, "send update publickey request")
+		publicKey, err := s.client.UpdatePublicKey(ctx, state.ID.ValueString(), api.PublicKeyRequest{
+			Name:      plan.Name.ValueString(),
+			PublicKey: state.Key.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating publickey",
+				"Could not update publickey id "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		plan.Name = types.StringValue(publicKey.Name)
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish updating publickey request")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -221,3 +323,44 @@ func (s *PublicKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setKeyInfo populate the computed key details from the parsed public key.
+func (m *PublicKeyResourceModel) setKeyInfo(key string) {
+	info, err := helper.ParsePublicKey(key)
+	if err != nil {
+		m.FingerprintSHA256 = types.StringNull()
+		m.FingerprintMD5 = types.StringNull()
+		m.Algorithm = types.StringNull()
+		m.Bits = types.Int64Null()
+		return
+	}
+	m.FingerprintSHA256 = types.StringValue(info.FingerprintSHA256)
+	m.FingerprintMD5 = types.StringValue(info.FingerprintMD5)
+	m.Algorithm = types.StringValue(info.Algorithm)
+	m.Bits = types.Int64Value(int64(info.Bits))
+}
+
+// publicKeyValidator reject malformed SSH public keys and private keys at plan time.
+type publicKeyValidator struct{}
+
+func (v publicKeyValidator) Description(_ context.Context) string {
+	return "value must be an SSH public key in authorized_keys format"
+}
+
+func (v publicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := helper.ParsePublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH public key",
+			"The public_key value is not a valid SSH public key: "+err.Error(),
+		)
+	}
+}
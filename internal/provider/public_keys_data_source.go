@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+)
+
+var (
+	_ datasource.DataSource              = &PublicKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &PublicKeysDataSource{}
+)
+
+type PublicKeysDataSource struct {
+	client *api.Client
+}
+
+type (
+	PublicKeysDataSourceModel struct {
+		NameRegex  types.String     `tfsdk:"name_regex"`
+		PublicKeys []PublicKeyModel `tfsdk:"public_keys"`
+	}
+	PublicKeyModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Key         types.String `tfsdk:"key"`
+		Created     types.String `tfsdk:"created"`
+		Fingerprint types.String `tfsdk:"fingerprint"`
+	}
+)
+
+func NewPublicKeysDataSource() datasource.DataSource {
+	return &PublicKeysDataSource{}
+}
+
+func (*PublicKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *PublicKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Regex the key name must match",
+			},
+			"public_keys": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key name",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key content",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date/time",
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed:    true,
+							Description: "SHA256 fingerprint of the key",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PublicKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected PublicKeys Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *PublicKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `public_keys` data source")
+	var state PublicKeysDataSourceModel
+
+	// get the user supplied data from the tf datasoruce block
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name_regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	publicKeys, err := d.client.ListPublicKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `public_key`",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.PublicKeys = []PublicKeyModel{}
+	for _, publicKey := range publicKeys {
+		if nameRegex != nil && !nameRegex.MatchString(publicKey.Name) {
+			continue
+		}
+		publicKeyState := PublicKeyModel{
+			ID:          types.StringValue(strconv.Itoa(publicKey.ID)),
+			Name:        types.StringValue(publicKey.Name),
+			Key:         types.StringValue(publicKey.Key),
+			Created:     types.StringValue(publicKey.Created),
+			Fingerprint: types.StringNull(),
+		}
+		if info, err := helper.ParsePublicKey(publicKey.Key); err == nil {
+			publicKeyState.Fingerprint = types.StringValue(info.FingerprintSHA256)
+		}
+		state.PublicKeys = append(state.PublicKeys, publicKeyState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `public_keys` data source", map[string]any{"success": true})
+}
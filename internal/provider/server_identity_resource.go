@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &ServerIdentityResource{}
+	_ resource.ResourceWithConfigure   = &ServerIdentityResource{}
+	_ resource.ResourceWithImportState = &ServerIdentityResource{}
+)
+
+// NewServerIdentityResource is a helper function to simplify the provider implementation.
+func NewServerIdentityResource() resource.Resource {
+	return &ServerIdentityResource{}
+}
+
+// ServerIdentityResource is the resource implementation.
+type ServerIdentityResource struct {
+	client *api.Client
+}
+
+// ServerIdentityResource is the model implementation.
+type ServerIdentityResourceModel struct {
+	ServerSlug        types.String   `tfsdk:"server_slug"`
+	MainDomain        types.String   `tfsdk:"main_domain"`
+	AliasDomains      []types.String `tfsdk:"alias_domains"`
+	LetsEncrypt       types.Bool     `tfsdk:"lets_encrypt"`
+	CertificateStatus types.String   `tfsdk:"certificate_status"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (s *ServerIdentityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_identity"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServerIdentityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ServerIdentity Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *ServerIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"main_domain": schema.StringAttribute{
+				Required:    true,
+				Description: "Main domain and hostname of the server",
+			},
+			"alias_domains": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Alias domains of the server",
+			},
+			"lets_encrypt": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Request a Let's Encrypt certificate for the main and alias domains",
+			},
+			"certificate_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the server certificate",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Import using server slug as the attribute
+func (s *ServerIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_slug"), req, resp)
+}
+
+// Create a new resource.
+func (s *ServerIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create server identity")
+	// Retrieve values from plan
+	var plan ServerIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating server identity",
+			"Could not set identity of webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create server identity request")
+}
+
+// Read resource information.
+func (s *ServerIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read server identity")
+
+	// Get current state
+	var state ServerIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send get server identity request")
+	identity, err := s.client.GetServerIdentity(ctx, state.ServerSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock server identity",
+			"Could not read identity of Webdock server "+state.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	certificate, err := s.client.GetServerCertificate(ctx, state.ServerSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock server certificate",
+			"Could not read certificate of Webdock server "+state.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.MainDomain = types.StringValue(identity.MainDomain)
+	if len(identity.AliasDomains) > 0 || state.AliasDomains != nil {
+		state.AliasDomains = []types.String{}
+		for _, alias := range identity.AliasDomains {
+			state.AliasDomains = append(state.AliasDomains, types.StringValue(alias))
+		}
+	}
+	if state.LetsEncrypt.IsNull() {
+		state.LetsEncrypt = types.BoolValue(certificate.Status != "")
+	}
+	state.CertificateStatus = types.StringValue(certificate.Status)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get server identity request")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *ServerIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "update server identity")
+	// Retrieve values from plan
+	var plan ServerIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating server identity",
+			"Could not set identity of webdock server "+plan.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update server identity request")
+}
+
+// Delete removes the Terraform state, the server keeps its current identity.
+func (s *ServerIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete server identity from state")
+}
+
+// apply set the server identity, request the certificate when enabled
+// and wait for both async jobs to finish.
+func (s *ServerIdentityResource) apply(ctx context.Context, plan *ServerIdentityResourceModel) error {
+	slug := plan.ServerSlug.ValueString()
+
+	domains := []string{plan.MainDomain.ValueString()}
+	aliases := []string{}
+	for _, alias := range plan.AliasDomains {
+		aliases = append(aliases, alias.ValueString())
+	}
+	domains = append(domains, aliases...)
+
+	tflog.Debug(ctx, "send update server identity request")
+	callbackID, err := s.client.UpdateServerIdentity(ctx, slug, api.ServerIdentityRequest{
+		MainDomain:   plan.MainDomain.ValueString(),
+		AliasDomains: aliases,
+	})
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "wait for server identity update", map[string]any{"callback_id": callbackID})
+	if err := s.client.WaitForCallback(ctx, callbackID); err != nil {
+		return err
+	}
+
+	if plan.LetsEncrypt.ValueBool() {
+		tflog.Debug(ctx, "send request server certificate request")
+		callbackID, err := s.client.RequestServerCertificate(ctx, slug, api.CertificateRequest{Domains: domains})
+		if err != nil {
+			return err
+		}
+		tflog.Debug(ctx, "wait for server certificate", map[string]any{"callback_id": callbackID})
+		if err := s.client.WaitForCallback(ctx, callbackID); err != nil {
+			return err
+		}
+	}
+
+	certificate, err := s.client.GetServerCertificate(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	plan.CertificateStatus = types.StringValue(certificate.Status)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+var (
+	_ datasource.DataSource              = &ServerNetworkDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServerNetworkDataSource{}
+)
+
+type ServerNetworkDataSource struct {
+	client *api.Client
+}
+
+type (
+	ServerNetworkDataSourceModel struct {
+		ServerSlug types.String     `tfsdk:"server_slug"`
+		Addresses  []IPAddressModel `tfsdk:"addresses"`
+	}
+	IPAddressModel struct {
+		Address    types.String `tfsdk:"address"`
+		Version    types.Int64  `tfsdk:"version"`
+		Gateway    types.String `tfsdk:"gateway"`
+		Netmask    types.String `tfsdk:"netmask"`
+		Prefix     types.Int64  `tfsdk:"prefix"`
+		ReverseDNS types.String `tfsdk:"reverse_dns"`
+	}
+)
+
+func NewServerNetworkDataSource() datasource.DataSource {
+	return &ServerNetworkDataSource{}
+}
+
+func (*ServerNetworkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_network"
+}
+
+// Schema defines the schema for the data source.
+func (d *ServerNetworkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the server",
+			},
+			"addresses": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "IP address",
+						},
+						"version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "IP version, 4 or 6",
+						},
+						"gateway": schema.StringAttribute{
+							Computed:    true,
+							Description: "Gateway of the address network",
+						},
+						"netmask": schema.StringAttribute{
+							Computed:    true,
+							Description: "Netmask of the address network",
+						},
+						"prefix": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Prefix length of the address network",
+						},
+						"reverse_dns": schema.StringAttribute{
+							Computed:    true,
+							Description: "Reverse DNS (PTR record) of the address",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServerNetworkDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Server Network Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *ServerNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `server_network` data source")
+	var state ServerNetworkDataSourceModel
+
+	// get the user supplied data from the tf datasoruce block
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addresses, err := d.client.ListServerAddresses(ctx, state.ServerSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `server_network` addresses",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Addresses = []IPAddressModel{}
+	for _, address := range addresses {
+		addressState := IPAddressModel{
+			Address:    types.StringValue(address.Address),
+			Version:    types.Int64Value(address.Version),
+			Gateway:    types.StringValue(address.Gateway),
+			Netmask:    types.StringValue(address.Netmask),
+			Prefix:     types.Int64Value(address.Prefix),
+			ReverseDNS: types.StringValue(address.ReverseDNS),
+		}
+		state.Addresses = append(state.Addresses, addressState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `server_network` data source", map[string]any{"success": true})
+}
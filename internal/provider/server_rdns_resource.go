@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// implement resource interfaces.
+var (
+	_ resource.Resource                = &ServerRDNSResource{}
+	_ resource.ResourceWithConfigure   = &ServerRDNSResource{}
+	_ resource.ResourceWithImportState = &ServerRDNSResource{}
+)
+
+// NewServerRDNSResource is a helper function to simplify the provider implementation.
+func NewServerRDNSResource() resource.Resource {
+	return &ServerRDNSResource{}
+}
+
+// ServerRDNSResource is the resource implementation.
+type ServerRDNSResource struct {
+	client *api.Client
+}
+
+// ServerRDNSResource is the model implementation.
+type ServerRDNSResourceModel struct {
+	ServerSlug  types.String `tfsdk:"server_slug"`
+	Address     types.String `tfsdk:"address"`
+	ReverseDNS  types.String `tfsdk:"reverse_dns"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (s *ServerRDNSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_rdns"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServerRDNSResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*webdockProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ServerRDNS Data Source Configure Type",
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (s *ServerRDNSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "IPv4 or IPv6 address of the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reverse_dns": schema.StringAttribute{
+				Required:    true,
+				Description: "Reverse DNS (PTR record) of the address e.g. mail.example.com",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Import using "<server_slug>/<address>" as the ID
+func (s *ServerRDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	slug, address, ok := strings.Cut(req.ID, "/")
+	if !ok || slug == "" || address == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: <server_slug>/<address>. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_slug"), slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), address)...)
+}
+
+// Create a new resource.
+func (s *ServerRDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "create server reverse dns")
+	// Retrieve values from plan
+	var plan ServerRDNSResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateReverseDNS(ctx, plan.ServerSlug.ValueString(), plan.Address.ValueString(), plan.ReverseDNS.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating server reverse dns",
+			"Could not set reverse dns of "+plan.Address.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish create server reverse dns request")
+}
+
+// Read resource information.
+func (s *ServerRDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "read server reverse dns")
+
+	// Get current state
+	var state ServerRDNSResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "send list server addresses request")
+	addresses, err := s.client.ListServerAddresses(ctx, state.ServerSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock server reverse dns",
+			"Could not list addresses of Webdock server "+state.ServerSlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var address *api.IPAddress
+	for i := range addresses {
+		if addresses[i].Address == state.Address.ValueString() {
+			address = &addresses[i]
+			break
+		}
+	}
+	// The address is no longer assigned to the server
+	if address == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ReverseDNS = types.StringValue(address.ReverseDNS)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish get server reverse dns request")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *ServerRDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "update server reverse dns")
+	// Retrieve values from plan
+	var plan ServerRDNSResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateReverseDNS(ctx, plan.ServerSlug.ValueString(), plan.Address.ValueString(), plan.ReverseDNS.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating server reverse dns",
+			"Could not set reverse dns of "+plan.Address.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update server reverse dns request")
+}
+
+// Delete restores the Webdock default reverse dns and removes the Terraform state on success.
+func (s *ServerRDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "delete server reverse dns")
+	// Get current state
+	var state ServerRDNSResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := s.updateReverseDNS(ctx, state.ServerSlug.ValueString(), state.Address.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleteing webdock server reverse dns",
+			"Could not reset reverse dns of "+state.Address.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// updateReverseDNS set the PTR record of the address and wait for it to be applied.
+func (s *ServerRDNSResource) updateReverseDNS(ctx context.Context, slug string, address string, reverseDNS string) error {
+	tflog.Debug(ctx, "send update reverse dns request")
+	callbackID, err := s.client.UpdateReverseDNS(ctx, slug, api.ReverseDNSRequest{
+		Address:    address,
+		ReverseDNS: reverseDNS,
+	})
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "wait for reverse dns update", map[string]any{"callback_id": callbackID})
+	return s.client.WaitForCallback(ctx, callbackID)
+}
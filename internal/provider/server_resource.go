@@ -2,14 +2,24 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hmada15/terraform-provider-webdock/api"
@@ -18,10 +28,11 @@ import (
 
 // implement resource interfaces.
 var (
-	_ resource.Resource                = &ServerResource{}
-	_ resource.ResourceWithConfigure   = &ServerResource{}
-	_ resource.ResourceWithModifyPlan  = &ServerResource{}
-	_ resource.ResourceWithImportState = &ServerResource{}
+	_ resource.Resource                   = &ServerResource{}
+	_ resource.ResourceWithConfigure      = &ServerResource{}
+	_ resource.ResourceWithModifyPlan     = &ServerResource{}
+	_ resource.ResourceWithImportState    = &ServerResource{}
+	_ resource.ResourceWithValidateConfig = &ServerResource{}
 )
 
 // NewServerResource is a helper function to simplify the provider implementation.
@@ -31,7 +42,8 @@ func NewServerResource() resource.Resource {
 
 // ServerResource is the resource implementation.
 type ServerResource struct {
-	client *api.Client
+	client      *api.Client
+	defaultTags []string
 }
 
 // ServerResource is the model implementation.
@@ -41,6 +53,8 @@ type ServerResourceModel struct {
 	LocationID             types.String `tfsdk:"location_id"`
 	ProfileSlug            types.String `tfsdk:"profile_slug"`
 	ImageSlug              types.String `tfsdk:"image_slug"`
+	SourceServerSlug       types.String `tfsdk:"source_server_slug"`
+	SourceSnapshotID       types.Int64  `tfsdk:"source_snapshot_id"`
 	Date                   types.String `tfsdk:"date"`
 	Location               types.String `tfsdk:"location"`
 	Image                  types.String `tfsdk:"image"`
@@ -50,9 +64,18 @@ type ServerResourceModel struct {
 	Status                 types.String `tfsdk:"status"`
 	Virtualization         types.String `tfsdk:"virtualization"`
 	WebServer              types.String `tfsdk:"web_server"`
+	PhpVersion             types.String `tfsdk:"php_version"`
 	SnapshotRunTime        types.Int64  `tfsdk:"snapshot_run_time"`
+	SnapshotRetention      types.Int64  `tfsdk:"snapshot_retention"`
 	WordPressLockDown      types.Bool   `tfsdk:"word_press_lock_down"`
 	SSHPasswordAuthEnabled types.Bool   `tfsdk:"ssh_password_auth_enabled"`
+	ReinstallOnImageChange types.Bool   `tfsdk:"reinstall_on_image_change"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	Description            types.String `tfsdk:"description"`
+	Tags                   types.Set    `tfsdk:"tags"`
+	TagsAll                types.Set    `tfsdk:"tags_all"`
+	MonthlyPrice           types.Int64  `tfsdk:"monthly_price"`
+	Currency               types.String `tfsdk:"currency"`
 	LastUpdated            types.String `tfsdk:"last_updated"`
 }
 
@@ -67,16 +90,17 @@ func (d *ServerResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	data, ok := req.ProviderData.(*webdockProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Server Data Source Configure Type",
-			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *webdockProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	d.client = client
+	d.client = data.client
+	d.defaultTags = data.defaultTags
 }
 
 // Schema defines the schema for the resource.
@@ -86,8 +110,9 @@ func (s *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"slug": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
-				// Requires Replace if the value change
+				// Requires Replace if the value change, an unset slug keeps the one in state
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Description: "Must be unique",
@@ -95,6 +120,23 @@ func (s *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Server description",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Server tags e.g. team or environment, stored in a [tags] section of the Webdock server notes. Must not contain commas",
+				Validators: []validator.Set{
+					tagsValidator{},
+				},
+			},
+			"tags_all": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Server tags merged with the provider default_tags",
+			},
 			"location_id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the location. Get this from the /locations endpoint.",
@@ -113,16 +155,54 @@ func (s *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed: true,
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"image_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "Slug of the server image. Get this from the /images endpoint. You must pass either this parameter or snapshotId",
+				Optional:    true,
+				Computed:    true,
+				Description: "Slug of the server image. Get this from the /images endpoint. You must pass either this parameter or source_server_slug",
+				// Requires Replace unless reinstall_on_image_change is enabled
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							var reinstall types.Bool
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("reinstall_on_image_change"), &reinstall)...)
+							resp.RequiresReplace = !reinstall.ValueBool()
+						},
+						"Replace the server when the image change unless reinstall_on_image_change is enabled.",
+						"Replace the server when the image change unless `reinstall_on_image_change` is enabled.",
+					),
+				},
+			},
+			"source_server_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "Slug of the server to clone, the new server is created from its latest snapshot or from source_snapshot_id. You must pass either this parameter or image_slug",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"source_snapshot_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the source_server_slug snapshot to clone, defaults to its latest completed snapshot",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"reinstall_on_image_change": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Reinstall the server in place when image_slug change instead of replacing it. All data on the server is lost but the slug and IPs are kept.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Prevent the server from being destroyed or replaced. Must be set to false in a prior apply before the server can be destroyed.",
+			},
 			"date": schema.StringAttribute{
 				Computed: true,
 			},
@@ -145,16 +225,66 @@ func (s *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed: true,
 			},
 			"web_server": schema.StringAttribute{
-				Computed: true,
+				Computed:    true,
+				Optional:    true,
+				Description: "Webserver type Enum: apache, nginx, none. Changed in place",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"php_version": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "PHP version e.g. 8.1. Changed in place",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"snapshot_run_time": schema.Int64Attribute{
-				Computed: true,
+				Computed:    true,
+				Optional:    true,
+				Description: "Time of day the daily snapshot runs, in seconds after midnight. Changed in place",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_retention": schema.Int64Attribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Number of daily snapshots to keep. Changed in place",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"word_press_lock_down": schema.BoolAttribute{
-				Computed: true,
+				Computed:    true,
+				Optional:    true,
+				Description: "Wordpress lockdown status, changed in place",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"ssh_password_auth_enabled": schema.BoolAttribute{
-				Computed: true,
+				Computed:    true,
+				Optional:    true,
+				Description: "SSH Password Authentication Enabled for this Server, changed in place",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monthly_price": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Monthly price amount of the server profile as reported by the profile catalogue",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency of the monthly price",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -163,49 +293,491 @@ func (s *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+// ValidateConfig checks the server is created from exactly one of an image or a source
+// server.
+func (s *ServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ServerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values coming from other resources are checked once they are known
+	if config.ImageSlug.IsUnknown() || config.SourceServerSlug.IsUnknown() {
+		return
+	}
+	if config.ImageSlug.IsNull() == config.SourceServerSlug.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("image_slug"),
+			"Invalid server source",
+			"Exactly one of image_slug or source_server_slug must be set.",
+		)
+	}
+	if !config.SourceSnapshotID.IsNull() && config.SourceServerSlug.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_snapshot_id"),
+			"Missing source_server_slug",
+			"source_snapshot_id can only be used together with source_server_slug.",
+		)
+	}
+}
+
 // ModifyPlan tailor the plan to match the expected end state.
 func (s *ServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Check if the resource is being created.
-	if req.State.Raw.IsNull() {
-		tflog.Debug(ctx, "start ModifyPlan")
+	// Check if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Server Deletion requires special privileges which cannot be obtained in the Webdock dashboard without first contacting Webdock Support!",
+			"This will nuke the server from orbit including all data and server snapshots. Use with care.",
+		)
 
 		var state ServerResourceModel
-		diags := req.Plan.Get(ctx, &state)
+		diags := req.State.Get(ctx, &state)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		tflog.Debug(ctx, "check if server exist")
-		// check if a server with the slug exist
-		exist, err := s.client.ServerExist(ctx, state.Slug.ValueString())
-		if err != nil {
+		if state.DeletionProtection.ValueBool() {
 			resp.Diagnostics.AddError(
-				"Error checking Webdock server exist",
-				"Error: "+err.Error(),
+				"Server "+state.Slug.ValueString()+" has deletion protection enabled",
+				"The server cannot be destroyed while deletion_protection is true. "+
+					"Set deletion_protection to false and apply that change first.",
 			)
 			return
 		}
-		if exist == helper.YES {
+		if !state.MonthlyPrice.IsNull() && !state.MonthlyPrice.IsUnknown() {
+			addCostWarning(&resp.Diagnostics, state.Name.ValueString(), -state.MonthlyPrice.ValueInt64(), state.Currency.ValueString())
+		}
+		return
+	}
+
+	tflog.Debug(ctx, "start ModifyPlan")
+
+	var plan ServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServerResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Refuse to replace a protected server, the flag in state is checked so it
+	// must be turned off by a prior apply
+	if !req.State.Raw.IsNull() && state.DeletionProtection.ValueBool() {
+		if replaced := replacedAttributes(plan, state); len(replaced) > 0 {
 			resp.Diagnostics.AddError(
-				"Server with the same slug exist",
-				"Webdock require a unique slug for each server and a server with the slug"+
-					state.Slug.ValueString()+" already exists please choose new slug",
+				"Server "+state.Slug.ValueString()+" has deletion protection enabled",
+				"This plan replaces the server because of changes to "+strings.Join(replaced, ", ")+
+					". The server cannot be replaced while deletion_protection is true. "+
+					"Set deletion_protection to false and apply that change first.",
 			)
+			return
 		}
 	}
-	// Check if the resource is being destroyed.
-	if req.Plan.Raw.IsNull() {
-		resp.Diagnostics.AddWarning(
-			"Server Deletion requires special privileges which cannot be obtained in the Webdock dashboard without first contacting Webdock Support!",
-			"This will nuke the server from orbit including all data and server snapshots. Use with care.",
+
+	// Check if the resource is being created.
+	if req.State.Raw.IsNull() {
+		var configSlug types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("slug"), &configSlug)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Slug = s.plannedSlug(ctx, configSlug, plan.Slug, plan.Name, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Warn loudly when the image change reinstall the server in place
+	if !req.State.Raw.IsNull() && plan.ReinstallOnImageChange.ValueBool() &&
+		!plan.ImageSlug.IsUnknown() && !plan.ImageSlug.Equal(state.ImageSlug) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("image_slug"),
+			"Server "+state.Slug.ValueString()+" will be reinstalled, ALL DATA ON THE SERVER WILL BE LOST",
+			"image_slug change from "+state.ImageSlug.ValueString()+" to "+plan.ImageSlug.ValueString()+
+				" and reinstall_on_image_change is enabled. The server keeps its slug and IP addresses but its disk is wiped "+
+				"and reinstalled from the new image. Take a snapshot first if you need any data from it.",
 		)
 	}
+
+	// The settings come from the image, unconfigured ones are only known once
+	// the server is reinstalled and php_version once the web server is switched
+	if !req.State.Raw.IsNull() {
+		var config ServerResourceModel
+		diags = req.Config.Get(ctx, &config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.unknownUnconfiguredSettings(config, state)
+	}
+
+	profile := s.validateCatalogue(ctx, plan, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the monthly price of the planned profile
+	if profile != nil {
+		plan.MonthlyPrice = types.Int64Value(int64(profile.Price.Amount))
+		plan.Currency = types.StringValue(profile.Price.Currency)
+
+		delta := plan.MonthlyPrice.ValueInt64()
+		if !req.State.Raw.IsNull() {
+			delta -= state.MonthlyPrice.ValueInt64()
+		}
+		if req.State.Raw.IsNull() || delta != 0 {
+			addCostWarning(&resp.Diagnostics, plan.Name.ValueString(), delta, plan.Currency.ValueString())
+		}
+	} else if !plan.LocationID.Equal(state.LocationID) || !plan.ProfileSlug.Equal(state.ProfileSlug) {
+		plan.MonthlyPrice = types.Int64Unknown()
+		plan.Currency = types.StringUnknown()
+	}
+
+	// Merge the provider default tags into tags_all
+	if plan.Tags.IsUnknown() {
+		plan.TagsAll = types.SetUnknown(types.StringType)
+	} else {
+		plan.TagsAll = stringsToSet(helper.MergeTags(s.defaultTags, setValues(plan.Tags)))
+	}
+
+	diags = resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// unknownUnconfiguredSettings marks the settings kept from state as unknown when the planned
+// image or web server change would reset them.
+func (m *ServerResourceModel) unknownUnconfiguredSettings(config, state ServerResourceModel) {
+	if !m.ImageSlug.Equal(state.ImageSlug) {
+		if config.WebServer.IsNull() {
+			m.WebServer = types.StringUnknown()
+		}
+		if config.PhpVersion.IsNull() {
+			m.PhpVersion = types.StringUnknown()
+		}
+		if config.SnapshotRunTime.IsNull() {
+			m.SnapshotRunTime = types.Int64Unknown()
+		}
+		if config.SnapshotRetention.IsNull() {
+			m.SnapshotRetention = types.Int64Unknown()
+		}
+		if config.WordPressLockDown.IsNull() {
+			m.WordPressLockDown = types.BoolUnknown()
+		}
+		if config.SSHPasswordAuthEnabled.IsNull() {
+			m.SSHPasswordAuthEnabled = types.BoolUnknown()
+		}
+	}
+
+	if !strings.EqualFold(m.WebServer.ValueString(), state.WebServer.ValueString()) && config.PhpVersion.IsNull() {
+		m.PhpVersion = types.StringUnknown()
+	}
+}
+
+// addCostWarning summarize the monthly cost change of a server for plan approvers.
+func addCostWarning(diags *diag.Diagnostics, name string, delta int64, currency string) {
+	sign := "+"
+	if delta < 0 {
+		sign = "-"
+		delta = -delta
+	}
+	diags.AddWarning(
+		"Estimated monthly cost change for server "+name,
+		"This plan changes the monthly cost by "+sign+strconv.FormatInt(delta, 10)+" "+currency+
+			" (price amount as reported by the Webdock profile catalogue).",
+	)
+}
+
+// resolvePrice set the monthly price of the server when it is unknown in the plan,
+// the price is informational so a failed lookup leaves it null with a warning.
+func (s *ServerResource) resolvePrice(ctx context.Context, plan *ServerResourceModel, server api.Server, diags *diag.Diagnostics) {
+	if !plan.MonthlyPrice.IsUnknown() && !plan.Currency.IsUnknown() {
+		return
+	}
+
+	plan.MonthlyPrice = types.Int64Null()
+	plan.Currency = types.StringNull()
+	price, err := s.profilePrice(ctx, server.Location, server.Profile)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read server price",
+			"Could not read price of server profile "+server.Profile+", monthly_price is left empty: "+err.Error(),
+		)
+		return
+	}
+	plan.MonthlyPrice = types.Int64Value(int64(price.Amount))
+	plan.Currency = types.StringValue(price.Currency)
+}
+
+// profilePrice looks up the price of a profile at a location in the Webdock catalogue.
+func (s *ServerResource) profilePrice(ctx context.Context, locationId, profileSlug string) (api.Price, error) {
+	profiles, err := s.client.ListProfiles(ctx, locationId)
+	if err != nil {
+		return api.Price{}, err
+	}
+	for _, profile := range profiles {
+		if profile.Slug == profileSlug {
+			return profile.Price, nil
+		}
+	}
+
+	return api.Price{}, errors.New("profile " + profileSlug + " is not offered at location " + locationId)
+}
+
+// replacedAttributes returns the attributes whose planned change replace the server.
+// The attribute plan modifiers already ran but their replace paths are not passed
+// to ModifyPlan, so the same rules are applied again here. Unknown values count
+// as a change like they do for RequiresReplace.
+func replacedAttributes(plan, state ServerResourceModel) []string {
+	var replaced []string
+	for _, attribute := range []struct {
+		name           string
+		planned, known attr.Value
+	}{
+		{"slug", plan.Slug, state.Slug},
+		{"location_id", plan.LocationID, state.LocationID},
+		{"profile_slug", plan.ProfileSlug, state.ProfileSlug},
+		{"virtualization", plan.Virtualization, state.Virtualization},
+		{"source_server_slug", plan.SourceServerSlug, state.SourceServerSlug},
+		{"source_snapshot_id", plan.SourceSnapshotID, state.SourceSnapshotID},
+	} {
+		if !attribute.planned.Equal(attribute.known) {
+			replaced = append(replaced, attribute.name)
+		}
+	}
+	if !plan.ImageSlug.Equal(state.ImageSlug) && !plan.ReinstallOnImageChange.ValueBool() {
+		replaced = append(replaced, "image_slug")
+	}
+
+	return replaced
+}
+
+// plannedSlug returns the slug of a new server and checks no server already uses it.
+// Webdock derive the slug from the name when none is given, it is reproduced so the
+// derived slug is planned and conflicts are predicted at plan time.
+// A slug coming from another resource is unknown until apply, the check then runs
+// again when the plan is re-computed during apply.
+func (s *ServerResource) plannedSlug(ctx context.Context, configSlug, planSlug, name types.String, diags *diag.Diagnostics) types.String {
+	slug := planSlug
+	if configSlug.IsNull() && !name.IsUnknown() {
+		if derived := helper.SlugFromName(name.ValueString()); derived != "" {
+			slug = types.StringValue(derived)
+		}
+	}
+
+	if slug.IsUnknown() || slug.ValueString() == "" {
+		tflog.Debug(ctx, "skip server exist check, slug is unknown")
+		return slug
+	}
+
+	tflog.Debug(ctx, "check if server exist")
+	// check if a server with the slug exist
+	exist, err := s.client.ServerExist(ctx, slug.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error checking Webdock server exist",
+			"Error: "+err.Error(),
+		)
+		return slug
+	}
+	if exist == helper.YES {
+		diags.AddAttributeError(
+			path.Root("slug"),
+			"Server with the same slug exist",
+			"Webdock require a unique slug for each server and a server with the slug "+
+				slug.ValueString()+" already exists please choose new slug",
+		)
+	}
+
+	return slug
+}
+
+// validateCatalogue checks that the planned location, profile and image exist in the
+// Webdock catalogue so typos fail at plan time instead of mid-apply.
+// Only values that are known and differ from the current state are checked.
+// The planned profile is returned when it was looked up in the catalogue.
+func (s *ServerResource) validateCatalogue(ctx context.Context, plan, state ServerResourceModel, diags *diag.Diagnostics) *api.Profile {
+	changed := func(planned, current types.String) bool {
+		return !planned.IsUnknown() && !planned.IsNull() && !planned.Equal(current)
+	}
+
+	var planned *api.Profile
+	locationValid := false
+	if changed(plan.LocationID, state.LocationID) || changed(plan.ProfileSlug, state.ProfileSlug) {
+		tflog.Debug(ctx, "validate server location")
+		locations, err := s.client.ListLocations(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error listing Webdock locations",
+				"Error: "+err.Error(),
+			)
+			return nil
+		}
+		var ids []string
+		for _, location := range locations {
+			ids = append(ids, location.ID)
+			if location.ID == plan.LocationID.ValueString() {
+				locationValid = true
+			}
+		}
+		if !plan.LocationID.IsUnknown() && !locationValid {
+			diags.AddAttributeError(
+				path.Root("location_id"),
+				"Invalid location_id",
+				"Location "+plan.LocationID.ValueString()+" does not exist. Valid locations are: "+strings.Join(ids, ", "),
+			)
+		}
+	}
+
+	if locationValid && (changed(plan.ProfileSlug, state.ProfileSlug) || changed(plan.LocationID, state.LocationID)) {
+		tflog.Debug(ctx, "validate server profile")
+		profiles, err := s.client.ListProfiles(ctx, plan.LocationID.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Error listing Webdock profiles",
+				"Error: "+err.Error(),
+			)
+			return nil
+		}
+		if !plan.ProfileSlug.IsUnknown() {
+			var slugs []string
+			profileValid := false
+			for i, profile := range profiles {
+				slugs = append(slugs, profile.Slug)
+				if profile.Slug == plan.ProfileSlug.ValueString() {
+					profileValid = true
+					planned = &profiles[i]
+				}
+			}
+			if !profileValid {
+				diags.AddAttributeError(
+					path.Root("profile_slug"),
+					"Invalid profile_slug",
+					"Profile "+plan.ProfileSlug.ValueString()+" is not offered at location "+plan.LocationID.ValueString()+
+						". Valid profiles are: "+strings.Join(slugs, ", "),
+				)
+			}
+		}
+	}
+
+	if changed(plan.ImageSlug, state.ImageSlug) {
+		tflog.Debug(ctx, "validate server image")
+		images, err := s.client.ListImages(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error listing Webdock images",
+				"Error: "+err.Error(),
+			)
+			return nil
+		}
+		var slugs []string
+		imageValid := false
+		for _, image := range images {
+			slugs = append(slugs, image.Slug)
+			if image.Slug == plan.ImageSlug.ValueString() {
+				imageValid = true
+			}
+		}
+		if !imageValid {
+			diags.AddAttributeError(
+				path.Root("image_slug"),
+				"Invalid image_slug",
+				"Image "+plan.ImageSlug.ValueString()+" does not exist. Valid images are: "+strings.Join(slugs, ", "),
+			)
+		}
+	}
+
+	if changed(plan.WebServer, state.WebServer) || changed(plan.PhpVersion, state.PhpVersion) {
+		tflog.Debug(ctx, "validate server web server and php version")
+		images, err := s.client.ListImages(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error listing Webdock images",
+				"Error: "+err.Error(),
+			)
+			return nil
+		}
+		webServers := []string{"none"}
+		phpVersions := []string{}
+		for _, image := range images {
+			if image.WebServer != "" && !slices.Contains(webServers, strings.ToLower(image.WebServer)) {
+				webServers = append(webServers, strings.ToLower(image.WebServer))
+			}
+			if image.PhpVersion != "" && !slices.Contains(phpVersions, image.PhpVersion) {
+				phpVersions = append(phpVersions, image.PhpVersion)
+			}
+		}
+		if changed(plan.WebServer, state.WebServer) && !slices.Contains(webServers, strings.ToLower(plan.WebServer.ValueString())) {
+			diags.AddAttributeError(
+				path.Root("web_server"),
+				"Invalid web_server",
+				"Web server "+plan.WebServer.ValueString()+" is not offered. Valid web servers are: "+strings.Join(webServers, ", "),
+			)
+		}
+		if changed(plan.PhpVersion, state.PhpVersion) && !slices.Contains(phpVersions, plan.PhpVersion.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("php_version"),
+				"Invalid php_version",
+				"PHP version "+plan.PhpVersion.ValueString()+" is not offered. Valid PHP versions are: "+strings.Join(phpVersions, ", "),
+			)
+		}
+	}
+
+	return planned
 }
 
-// Import using slug as the attribute
+// Import using slug as the attribute, the ID can also be given as
+// ip:<address> or name:<server name> to look the server up by listing servers
 func (s *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	slug := req.ID
+
+	kind, value, found := strings.Cut(req.ID, ":")
+	if found && (kind == "ip" || kind == "name") {
+		tflog.Debug(ctx, "send list servers request")
+		servers, err := s.client.ListServers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Webdock server",
+				"Could not list Webdock servers: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []string
+		for _, server := range servers {
+			if (kind == "ip" && (server.Ipv4 == value || server.Ipv6 == value)) || (kind == "name" && server.Name == value) {
+				matches = append(matches, server.Slug)
+			}
+		}
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Server not found",
+				"No Webdock server matches the import ID "+req.ID,
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Ambiguous server import ID",
+				"The import ID "+req.ID+" matches multiple Webdock servers: "+strings.Join(matches, ", ")+
+					". Import the server by its slug instead.",
+			)
+			return
+		}
+		slug = matches[0]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
 }
 
 // Create a new resource.
@@ -228,8 +800,21 @@ func (s *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		Virtualization: plan.Virtualization.ValueString(),
 		ImageSlug:      plan.ImageSlug.ValueString(),
 	}
+	// Clone the source server from one of its snapshots
+	if !plan.SourceServerSlug.IsNull() {
+		snapshotID, err := s.sourceSnapshot(ctx, plan.SourceServerSlug.ValueString(), plan.SourceSnapshotID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating server",
+				"Could not find snapshot to clone webdock server "+plan.SourceServerSlug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		serverRequest.ImageSlug = ""
+		serverRequest.SnapshotID = snapshotID
+	}
 	tflog.Debug(ctx, "send create server request")
-	server, err := s.client.CreateServer(ctx, serverRequest)
+	server, callbackID, err := s.client.CreateServer(ctx, serverRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating server",
@@ -238,28 +823,72 @@ func (s *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Map response body to schema and populate Computed attribute values
-	plan = ServerResourceModel{
-		Slug:                   types.StringValue(server.Slug),
-		Name:                   types.StringValue(server.Name),
-		LocationID:             types.StringValue(server.Location),
-		ProfileSlug:            types.StringValue(server.Profile),
-		ImageSlug:              types.StringValue(server.Image),
-		Date:                   types.StringValue(server.Date),
-		Location:               types.StringValue(server.Location),
-		Image:                  types.StringValue(server.Image),
-		Profile:                types.StringValue(server.Profile),
-		Ipv4:                   types.StringValue(server.Ipv4),
-		Ipv6:                   types.StringValue(server.Ipv6),
-		Status:                 types.StringValue(server.Status),
-		Virtualization:         types.StringValue(server.Virtualization),
-		WebServer:              types.StringValue(server.WebServer),
-		SnapshotRunTime:        types.Int64Value(server.SnapshotRunTime),
-		WordPressLockDown:      types.BoolValue(server.WordPressLockDown),
-		SSHPasswordAuthEnabled: types.BoolValue(server.SSHPasswordAuthEnabled),
-		LastUpdated:            types.StringValue(time.Now().Format(time.RFC850)),
+	// Track the server as soon as it exists, a failure in the following
+	// steps then taints it instead of leaving an untracked server behind
+	created := plan
+	created.setServer(server, s.defaultTags)
+	if created.MonthlyPrice.IsUnknown() {
+		created.MonthlyPrice = types.Int64Null()
+		created.Currency = types.StringNull()
+	}
+	created.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags = resp.State.Set(ctx, created)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the configured settings once the server is provisioned,
+	// a clone is always waited for as its settings come from the snapshot
+	settingsRequest, settingsChanged := serverSettingsRequest(plan, created)
+	if settingsChanged || !plan.SourceServerSlug.IsNull() {
+		tflog.Debug(ctx, "wait for server provisioning", map[string]any{"callback_id": callbackID})
+		if err := s.client.WaitForCallback(ctx, callbackID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating server",
+				"Webdock server "+server.Slug+" provisioning did not finish: "+err.Error(),
+			)
+			return
+		}
+		if settingsChanged {
+			if err := s.updateSettings(ctx, server.Slug, settingsRequest); err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating server settings",
+					"Could not update settings of webdock server "+server.Slug+": "+err.Error(),
+				)
+				return
+			}
+		}
+		server, err = s.client.GetServerBYSlug(ctx, created.Slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Webdock server",
+				"Could not read Webdock server Slug "+created.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
+	// Set the description and tags which are not part of the create request
+	if updateRequest, changed := serverUpdateRequest(plan, created, server.Notes); changed {
+		tflog.Debug(ctx, "send update server request")
+		server, err = s.client.UpdateServer(ctx, created.Slug.ValueString(), updateRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating server",
+				"Could not update webdock server "+created.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Resolve the profile price when it was unknown at plan time
+	s.resolvePrice(ctx, &plan, server, &resp.Diagnostics)
+
+	// Map response body to schema and populate Computed attribute values
+	plan.setServer(server, s.defaultTags)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -292,25 +921,27 @@ func (s *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Resolve the profile price for imported servers, a profile no longer in
+	// the catalogue must not block the refresh so the price is left null
+	if state.MonthlyPrice.IsNull() || state.ProfileSlug.ValueString() != server.Profile {
+		state.MonthlyPrice = types.Int64Null()
+		state.Currency = types.StringNull()
+		price, err := s.profilePrice(ctx, server.Location, server.Profile)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read server price", map[string]any{"profile": server.Profile, "error": err.Error()})
+		} else {
+			state.MonthlyPrice = types.Int64Value(int64(price.Amount))
+			state.Currency = types.StringValue(price.Currency)
+		}
+	}
+
 	// Overwrite items with refreshed state
-	state = ServerResourceModel{
-		Slug:                   types.StringValue(server.Slug),
-		Name:                   types.StringValue(server.Name),
-		LocationID:             types.StringValue(server.Location),
-		ProfileSlug:            types.StringValue(server.Profile),
-		ImageSlug:              types.StringValue(server.Image),
-		Date:                   types.StringValue(server.Date),
-		Location:               types.StringValue(server.Location),
-		Image:                  types.StringValue(server.Image),
-		Profile:                types.StringValue(server.Profile),
-		Ipv4:                   types.StringValue(server.Ipv4),
-		Ipv6:                   types.StringValue(server.Ipv6),
-		Status:                 types.StringValue(server.Status),
-		Virtualization:         types.StringValue(server.Virtualization),
-		WebServer:              types.StringValue(server.WebServer),
-		SnapshotRunTime:        types.Int64Value(server.SnapshotRunTime),
-		WordPressLockDown:      types.BoolValue(server.WordPressLockDown),
-		SSHPasswordAuthEnabled: types.BoolValue(server.SSHPasswordAuthEnabled),
+	state.setServer(server, s.defaultTags)
+	if state.ReinstallOnImageChange.IsNull() {
+		state.ReinstallOnImageChange = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
 	}
 
 	// Set refreshed state
@@ -322,8 +953,260 @@ func (s *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 	tflog.Debug(ctx, "finish get server request")
 }
 
+// Update updates the resource and sets the updated Terraform state on success.
 func (s *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// updating resource is not supported
+	tflog.Debug(ctx, "update server")
+	// Retrieve values from plan
+	var plan ServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServerResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reinstall the server in place when the image change
+	reinstalled := !plan.ImageSlug.Equal(state.ImageSlug)
+	if reinstalled {
+		tflog.Debug(ctx, "send reinstall server request")
+		callbackID, err := s.client.ReinstallServer(ctx, state.Slug.ValueString(), plan.ImageSlug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reinstalling server",
+				"Could not reinstall webdock server "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "wait for server reinstall", map[string]any{"callback_id": callbackID})
+		if err := s.client.WaitForCallback(ctx, callbackID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reinstalling server",
+				"Webdock server "+state.Slug.ValueString()+" reinstall did not finish: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update the name, description and tags in place, the current notes
+	// are read so their free text is kept next to the tags
+	current, err := s.client.GetServerBYSlug(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock server",
+			"Could not read Webdock server Slug "+state.Slug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if updateRequest, changed := serverUpdateRequest(plan, state, current.Notes); changed {
+		tflog.Debug(ctx, "send update server request")
+		if _, err := s.client.UpdateServer(ctx, state.Slug.ValueString(), updateRequest); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating server",
+				"Could not update webdock server "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Apply the settings that differ from the server as it is now, a
+	// reinstall resets the settings so every configured one is applied again
+	var actual ServerResourceModel
+	if !reinstalled {
+		actual = state
+		actual.setServer(current, s.defaultTags)
+	}
+	if settingsRequest, changed := serverSettingsRequest(plan, actual); changed {
+		if err := s.updateSettings(ctx, state.Slug.ValueString(), settingsRequest); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating server settings",
+				"Could not update settings of webdock server "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "send get server request")
+	server, err := s.client.GetServerBYSlug(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webdock server",
+			"Could not read Webdock server Slug "+state.Slug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Resolve the profile price when it was unknown at plan time, this also
+	// covers servers whose price could not be read before
+	s.resolvePrice(ctx, &plan, server, &resp.Diagnostics)
+
+	// Map response body to schema and populate Computed attribute values
+	plan.setServer(server, s.defaultTags)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "finish update server request")
+}
+
+// serverUpdateRequest build the update request when the name, description or tags differ from current,
+// the tags are written into the current notes so their free text is kept.
+func serverUpdateRequest(plan, current ServerResourceModel, notes string) (api.ServerUpdateRequest, bool) {
+	updateRequest := api.ServerUpdateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Notes:       helper.EncodeTags(notes, setValues(plan.TagsAll)),
+	}
+	changed := !plan.Name.Equal(current.Name) ||
+		plan.Description.ValueString() != current.Description.ValueString() ||
+		updateRequest.Notes != notes
+
+	return updateRequest, changed
+}
+
+// serverSettingsRequest build the settings request for the configured values that differ from current.
+func serverSettingsRequest(plan, current ServerResourceModel) (api.ServerSettingsRequest, bool) {
+	var settingsRequest api.ServerSettingsRequest
+	changed := false
+
+	if !plan.WordPressLockDown.IsUnknown() && !plan.WordPressLockDown.IsNull() && !plan.WordPressLockDown.Equal(current.WordPressLockDown) {
+		settingsRequest.WordPressLockDown = plan.WordPressLockDown.ValueBoolPointer()
+		changed = true
+	}
+	if !plan.SSHPasswordAuthEnabled.IsUnknown() && !plan.SSHPasswordAuthEnabled.IsNull() && !plan.SSHPasswordAuthEnabled.Equal(current.SSHPasswordAuthEnabled) {
+		settingsRequest.SSHPasswordAuthEnabled = plan.SSHPasswordAuthEnabled.ValueBoolPointer()
+		changed = true
+	}
+	if !plan.WebServer.IsUnknown() && !plan.WebServer.IsNull() && !strings.EqualFold(plan.WebServer.ValueString(), current.WebServer.ValueString()) {
+		settingsRequest.WebServer = plan.WebServer.ValueStringPointer()
+		changed = true
+	}
+	if !plan.PhpVersion.IsUnknown() && !plan.PhpVersion.IsNull() && !plan.PhpVersion.Equal(current.PhpVersion) {
+		settingsRequest.PhpVersion = plan.PhpVersion.ValueStringPointer()
+		changed = true
+	}
+	if !plan.SnapshotRunTime.IsUnknown() && !plan.SnapshotRunTime.IsNull() && !plan.SnapshotRunTime.Equal(current.SnapshotRunTime) {
+		settingsRequest.SnapshotRunTime = plan.SnapshotRunTime.ValueInt64Pointer()
+		changed = true
+	}
+	if !plan.SnapshotRetention.IsUnknown() && !plan.SnapshotRetention.IsNull() && !plan.SnapshotRetention.Equal(current.SnapshotRetention) {
+		settingsRequest.SnapshotRetention = plan.SnapshotRetention.ValueInt64Pointer()
+		changed = true
+	}
+
+	return settingsRequest, changed
+}
+
+// sourceSnapshot returns the ID of the snapshot to clone, the requested one
+// when set otherwise the latest completed snapshot of the source server.
+func (s *ServerResource) sourceSnapshot(ctx context.Context, sourceSlug string, snapshotID types.Int64) (int, error) {
+	tflog.Debug(ctx, "list source server snapshots", map[string]any{"source_server_slug": sourceSlug})
+	snapshots, err := s.client.ListSnapshots(ctx, sourceSlug)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest *api.Snapshot
+	var latestDate time.Time
+	for i, snapshot := range snapshots {
+		if !snapshotID.IsNull() {
+			if int64(snapshot.ID) != snapshotID.ValueInt64() {
+				continue
+			}
+			if !snapshot.Completed {
+				return 0, fmt.Errorf("snapshot %d is not completed", snapshot.ID)
+			}
+			return snapshot.ID, nil
+		}
+
+		if !snapshot.Completed {
+			continue
+		}
+		date, err := helper.ParseTime(snapshot.Date)
+		if err != nil {
+			return 0, err
+		}
+		if latest == nil || date.After(latestDate) {
+			latest = &snapshots[i]
+			latestDate = date
+		}
+	}
+
+	if !snapshotID.IsNull() {
+		return 0, fmt.Errorf("snapshot %d does not exist", snapshotID.ValueInt64())
+	}
+	if latest == nil {
+		return 0, errors.New("server has no completed snapshot")
+	}
+	tflog.Debug(ctx, "clone latest snapshot", map[string]any{"snapshot_id": latest.ID, "date": latest.Date})
+
+	return latest.ID, nil
+}
+
+// updateSettings send the settings request and wait for the change to finish.
+func (s *ServerResource) updateSettings(ctx context.Context, slug string, settingsRequest api.ServerSettingsRequest) error {
+	tflog.Debug(ctx, "send update server settings request")
+	callbackID, err := s.client.UpdateServerSettings(ctx, slug, settingsRequest)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "wait for server settings update", map[string]any{"callback_id": callbackID})
+	return s.client.WaitForCallback(ctx, callbackID)
+}
+
+// setServer map the Webdock server to the model, provider side attributes are left untouched.
+// The default tags are only kept in tags_all unless they are also set on the resource.
+func (m *ServerResourceModel) setServer(server api.Server, defaultTags []string) {
+	m.Slug = types.StringValue(server.Slug)
+	m.Name = types.StringValue(server.Name)
+	m.LocationID = types.StringValue(server.Location)
+	m.ProfileSlug = types.StringValue(server.Profile)
+	m.ImageSlug = types.StringValue(server.Image)
+	m.Date = types.StringValue(server.Date)
+	m.Location = types.StringValue(server.Location)
+	m.Image = types.StringValue(server.Image)
+	m.Profile = types.StringValue(server.Profile)
+	m.Ipv4 = types.StringValue(server.Ipv4)
+	m.Ipv6 = types.StringValue(server.Ipv6)
+	m.Status = types.StringValue(server.Status)
+	m.Virtualization = types.StringValue(server.Virtualization)
+	// Webdock report the web server capitalized, it is stored lower case like
+	// the documented values unless the configured casing already matches
+	if !strings.EqualFold(m.WebServer.ValueString(), server.WebServer) {
+		m.WebServer = types.StringValue(strings.ToLower(server.WebServer))
+	}
+	m.PhpVersion = types.StringValue(server.PhpVersion)
+	m.SnapshotRunTime = types.Int64Value(server.SnapshotRunTime)
+	m.SnapshotRetention = types.Int64Value(server.SnapshotRetention)
+	// Keep unset description and tags null when Webdock has none
+	if server.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(server.Description)
+	}
+	tagsAll := helper.DecodeTags(server.Notes)
+	m.TagsAll = stringsToSet(tagsAll)
+	configured := setValues(m.Tags)
+	tags := []string{}
+	for _, tag := range tagsAll {
+		if !slices.Contains(defaultTags, tag) || slices.Contains(configured, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > 0 || !m.Tags.IsNull() {
+		m.Tags = stringsToSet(tags)
+	}
+	m.WordPressLockDown = types.BoolValue(server.WordPressLockDown)
+	m.SSHPasswordAuthEnabled = types.BoolValue(server.SSHPasswordAuthEnabled)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -336,6 +1219,14 @@ func (s *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Server "+state.Slug.ValueString()+" has deletion protection enabled",
+			"The server cannot be destroyed while deletion_protection is true.",
+		)
+		return
+	}
+
 	tflog.Debug(ctx, "send delete server request")
 	// delete server
 	err := s.client.DeleteServer(ctx, state.Slug.ValueString())
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hmada15/terraform-provider-webdock/api"
+)
+
+// newTestClient returns an api.Client whose requests are served by handler.
+// The requested paths are recorded in the returned slice.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*api.Client, *[]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	paths := []string{}
+	client := api.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		handler(w, r)
+	}))
+
+	return client, &paths
+}
+
+func TestPlannedSlugSkipsCheckWhenUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		configSlug types.String
+		name       types.String
+	}{
+		"slug from another resource": {
+			configSlug: types.StringUnknown(),
+			name:       types.StringValue("web01"),
+		},
+		"name from another resource": {
+			configSlug: types.StringNull(),
+			name:       types.StringUnknown(),
+		},
+		"name without letters or digits": {
+			configSlug: types.StringNull(),
+			name:       types.StringValue("---"),
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, paths := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s", r.URL.Path)
+			})
+			s := &ServerResource{client: client}
+
+			var diags diag.Diagnostics
+			slug := s.plannedSlug(context.Background(), tt.configSlug, types.StringUnknown(), tt.name, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !slug.IsUnknown() {
+				t.Errorf("expected unknown slug, got %s", slug)
+			}
+			if len(*paths) != 0 {
+				t.Errorf("expected no request, got %v", *paths)
+			}
+		})
+	}
+}
+
+func TestPlannedSlugChecksKnownSlug(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		configSlug types.String
+		planSlug   types.String
+		name       types.String
+		status     int
+		wantSlug   string
+		wantError  bool
+	}{
+		"configured slug is free": {
+			configSlug: types.StringValue("app01"),
+			planSlug:   types.StringValue("app01"),
+			name:       types.StringValue("Web 01"),
+			status:     http.StatusNotFound,
+			wantSlug:   "app01",
+		},
+		"configured slug is taken": {
+			configSlug: types.StringValue("app01"),
+			planSlug:   types.StringValue("app01"),
+			name:       types.StringValue("Web 01"),
+			status:     http.StatusOK,
+			wantSlug:   "app01",
+			wantError:  true,
+		},
+		"derived slug is free": {
+			configSlug: types.StringNull(),
+			planSlug:   types.StringUnknown(),
+			name:       types.StringValue("Web 01"),
+			status:     http.StatusNotFound,
+			wantSlug:   "web01",
+		},
+		"derived slug is taken": {
+			configSlug: types.StringNull(),
+			planSlug:   types.StringUnknown(),
+			name:       types.StringValue("Web 01"),
+			status:     http.StatusOK,
+			wantSlug:   "web01",
+			wantError:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, paths := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			})
+			s := &ServerResource{client: client}
+
+			var diags diag.Diagnostics
+			slug := s.plannedSlug(context.Background(), tt.configSlug, tt.planSlug, tt.name, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantError, diags)
+			}
+			if !slug.Equal(types.StringValue(tt.wantSlug)) {
+				t.Errorf("expected slug %s, got %s", tt.wantSlug, slug)
+			}
+			wantPath := "/v1/servers/" + tt.wantSlug
+			if len(*paths) != 1 || (*paths)[0] != wantPath {
+				t.Errorf("expected request to %s, got %v", wantPath, *paths)
+			}
+		})
+	}
+}
+
+func TestTagsValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tags      []string
+		wantError bool
+	}{
+		"plain tags":    {tags: []string{"env:prod", "team web"}},
+		"comma":         {tags: []string{"a,b"}, wantError: true},
+		"tags marker":   {tags: []string{"[/tags]"}, wantError: true},
+		"empty tag set": {tags: []string{}},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.SetRequest{
+				Path:        path.Root("tags"),
+				ConfigValue: stringsToSet(tt.tags),
+			}
+			var resp validator.SetResponse
+			tagsValidator{}.ValidateSet(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSetServerWebServerCasing(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		current types.String
+		want    string
+	}{
+		"imported server":         {current: types.StringNull(), want: "nginx"},
+		"configured lower case":   {current: types.StringValue("nginx"), want: "nginx"},
+		"configured capitalized":  {current: types.StringValue("Nginx"), want: "Nginx"},
+		"changed outside of plan": {current: types.StringValue("apache"), want: "nginx"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := ServerResourceModel{WebServer: tt.current}
+			model.setServer(api.Server{WebServer: "Nginx"}, nil)
+
+			if got := model.WebServer.ValueString(); got != tt.want {
+				t.Errorf("expected web_server %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestServerSettingsRequest(t *testing.T) {
+	t.Parallel()
+
+	plan := ServerResourceModel{
+		WebServer:         types.StringValue("nginx"),
+		PhpVersion:        types.StringValue("8.1"),
+		SnapshotRunTime:   types.Int64Unknown(),
+		WordPressLockDown: types.BoolValue(true),
+	}
+	server := plan
+	server.WebServer = types.StringValue("Nginx")
+
+	tests := map[string]struct {
+		current ServerResourceModel
+		want    api.ServerSettingsRequest
+		changed bool
+	}{
+		"unchanged": {current: server},
+		"reinstalled": {
+			current: ServerResourceModel{},
+			want: api.ServerSettingsRequest{
+				WebServer:         plan.WebServer.ValueStringPointer(),
+				PhpVersion:        plan.PhpVersion.ValueStringPointer(),
+				WordPressLockDown: plan.WordPressLockDown.ValueBoolPointer(),
+			},
+			changed: true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, changed := serverSettingsRequest(plan, tt.current)
+			if changed != tt.changed {
+				t.Fatalf("expected changed %t, got %t", tt.changed, changed)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected request %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnknownUnconfiguredSettings(t *testing.T) {
+	t.Parallel()
+
+	state := ServerResourceModel{
+		ImageSlug:              types.StringValue("webdock-ubuntu-jammy-cloud"),
+		WebServer:              types.StringValue("nginx"),
+		PhpVersion:             types.StringValue("8.1"),
+		SnapshotRunTime:        types.Int64Value(3600),
+		SnapshotRetention:      types.Int64Value(7),
+		WordPressLockDown:      types.BoolValue(false),
+		SSHPasswordAuthEnabled: types.BoolValue(false),
+	}
+
+	tests := map[string]struct {
+		config  ServerResourceModel
+		plan    func(*ServerResourceModel)
+		unknown []string
+	}{
+		"unchanged": {
+			plan: func(m *ServerResourceModel) {},
+		},
+		"image changed": {
+			config: ServerResourceModel{SSHPasswordAuthEnabled: types.BoolValue(false)},
+			plan: func(m *ServerResourceModel) {
+				m.ImageSlug = types.StringValue("webdock-ubuntu-noble-cloud")
+			},
+			unknown: []string{"web_server", "php_version", "snapshot_run_time", "snapshot_retention", "word_press_lock_down"},
+		},
+		"web server changed": {
+			config: ServerResourceModel{WebServer: types.StringValue("apache")},
+			plan: func(m *ServerResourceModel) {
+				m.WebServer = types.StringValue("apache")
+			},
+			unknown: []string{"php_version"},
+		},
+		"web server and php version changed": {
+			config: ServerResourceModel{WebServer: types.StringValue("apache"), PhpVersion: types.StringValue("8.2")},
+			plan: func(m *ServerResourceModel) {
+				m.WebServer = types.StringValue("apache")
+				m.PhpVersion = types.StringValue("8.2")
+			},
+		},
+		"web server casing": {
+			config: ServerResourceModel{WebServer: types.StringValue("Nginx")},
+			plan: func(m *ServerResourceModel) {
+				m.WebServer = types.StringValue("Nginx")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			tt.plan(&plan)
+			plan.unknownUnconfiguredSettings(tt.config, state)
+
+			got := map[string]bool{
+				"web_server":                plan.WebServer.IsUnknown(),
+				"php_version":               plan.PhpVersion.IsUnknown(),
+				"snapshot_run_time":         plan.SnapshotRunTime.IsUnknown(),
+				"snapshot_retention":        plan.SnapshotRetention.IsUnknown(),
+				"word_press_lock_down":      plan.WordPressLockDown.IsUnknown(),
+				"ssh_password_auth_enabled": plan.SSHPasswordAuthEnabled.IsUnknown(),
+			}
+			for attribute, unknown := range got {
+				if want := slices.Contains(tt.unknown, attribute); unknown != want {
+					t.Errorf("expected %s unknown %t, got %t", attribute, want, unknown)
+				}
+			}
+		})
+	}
+}
+
+// TestReplacedAttributesMatchSchema changes every attribute on its own and checks
+// replacedAttributes reports exactly those with a replace plan modifier.
+func TestReplacedAttributesMatchSchema(t *testing.T) {
+	t.Parallel()
+
+	var schemaResp resource.SchemaResponse
+	(&ServerResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	requiresReplace := func(modifiers ...any) bool {
+		for _, modifier := range modifiers {
+			if strings.Contains(fmt.Sprintf("%T", modifier), "requiresReplace") {
+				return true
+			}
+		}
+		return false
+	}
+
+	model := reflect.TypeOf(ServerResourceModel{})
+	for i := 0; i < model.NumField(); i++ {
+		name := model.Field(i).Tag.Get("tfsdk")
+		attribute, ok := schemaResp.Schema.Attributes[name]
+		if !ok {
+			t.Fatalf("model field %s has no schema attribute", name)
+		}
+
+		var replace bool
+		var changed attr.Value
+		switch attribute := attribute.(type) {
+		case schema.StringAttribute:
+			replace = requiresReplace(anySlice(attribute.PlanModifiers)...)
+			changed = types.StringValue("changed")
+		case schema.Int64Attribute:
+			replace = requiresReplace(anySlice(attribute.PlanModifiers)...)
+			changed = types.Int64Value(42)
+		case schema.BoolAttribute:
+			replace = requiresReplace(anySlice(attribute.PlanModifiers)...)
+			changed = types.BoolValue(true)
+		case schema.SetAttribute:
+			replace = requiresReplace(anySlice(attribute.PlanModifiers)...)
+			changed = stringsToSet([]string{"changed"})
+		default:
+			t.Fatalf("unexpected schema attribute type %T for %s", attribute, name)
+		}
+
+		t.Run(name, func(t *testing.T) {
+			var state, plan ServerResourceModel
+			reflect.ValueOf(&plan).Elem().Field(i).Set(reflect.ValueOf(changed))
+
+			replaced := replacedAttributes(plan, state)
+			if replace && !slices.Equal(replaced, []string{name}) {
+				t.Errorf("expected a change of %s to replace the server, got %v", name, replaced)
+			}
+			if !replace && len(replaced) > 0 {
+				t.Errorf("expected a change of %s to be applied in place, got %v", name, replaced)
+			}
+		})
+	}
+
+	t.Run("image_slug with reinstall_on_image_change", func(t *testing.T) {
+		plan := ServerResourceModel{
+			ImageSlug:              types.StringValue("changed"),
+			ReinstallOnImageChange: types.BoolValue(true),
+		}
+		if replaced := replacedAttributes(plan, ServerResourceModel{}); len(replaced) > 0 {
+			t.Errorf("expected the image change to reinstall in place, got %v", replaced)
+		}
+	})
+}
+
+func anySlice[T any](values []T) []any {
+	result := make([]any, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
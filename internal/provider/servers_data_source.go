@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hmada15/terraform-provider-webdock/api"
+	"github.com/hmada15/terraform-provider-webdock/helper"
+)
+
+var (
+	_ datasource.DataSource              = &ServersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServersDataSource{}
+)
+
+type ServersDataSource struct {
+	client *api.Client
+}
+
+type (
+	ServersDataSourceModel struct {
+		Tags    []types.String `tfsdk:"tags"`
+		Servers []ServersModel `tfsdk:"servers"`
+	}
+	ServersModel struct {
+		Slug        types.String   `tfsdk:"slug"`
+		Name        types.String   `tfsdk:"name"`
+		Location    types.String   `tfsdk:"location"`
+		Image       types.String   `tfsdk:"image"`
+		Profile     types.String   `tfsdk:"profile"`
+		Ipv4        types.String   `tfsdk:"ipv4"`
+		Ipv6        types.String   `tfsdk:"ipv6"`
+		Status      types.String   `tfsdk:"status"`
+		Description types.String   `tfsdk:"description"`
+		Tags        []types.String `tfsdk:"tags"`
+	}
+)
+
+func NewServersDataSource() datasource.DataSource {
+	return &ServersDataSource{}
+}
+
+func (*ServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+// Schema defines the schema for the data source.
+func (d *ServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list servers having all these tags",
+			},
+			"servers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server slug",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server name",
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "Location ID of the server",
+						},
+						"image": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server image",
+						},
+						"profile": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server profile",
+						},
+						"ipv4": schema.StringAttribute{
+							Computed:    true,
+							Description: "IPv4 address",
+						},
+						"ipv6": schema.StringAttribute{
+							Computed:    true,
+							Description: "IPv6 address",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server status",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server description",
+						},
+						"tags": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Server tags",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Servers Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read `servers` data source")
+	var state ServersDataSourceModel
+
+	// get the user supplied data from the tf datasoruce block
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list `server`",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Servers = []ServersModel{}
+	for _, server := range servers {
+		tags := helper.DecodeTags(server.Notes)
+		hasTags := true
+		for _, tag := range state.Tags {
+			if !slices.Contains(tags, tag.ValueString()) {
+				hasTags = false
+				break
+			}
+		}
+		if !hasTags {
+			continue
+		}
+
+		serverState := ServersModel{
+			Slug:        types.StringValue(server.Slug),
+			Name:        types.StringValue(server.Name),
+			Location:    types.StringValue(server.Location),
+			Image:       types.StringValue(server.Image),
+			Profile:     types.StringValue(server.Profile),
+			Ipv4:        types.StringValue(server.Ipv4),
+			Ipv6:        types.StringValue(server.Ipv6),
+			Status:      types.StringValue(server.Status),
+			Description: types.StringValue(server.Description),
+			Tags:        stringsToValues(tags),
+		}
+		state.Servers = append(state.Servers, serverState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading `servers` data source", map[string]any{"success": true})
+}